@@ -0,0 +1,144 @@
+package sentry
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const (
+	defaultAsyncTaskInterval    = time.Second
+	defaultAsyncTaskMaxInterval = 10 * time.Second
+	defaultAsyncTaskTimeout     = time.Minute
+)
+
+// Async task statuses.
+const (
+	AsyncTaskStatusPending = "pending"
+	AsyncTaskStatusSuccess = "success"
+	AsyncTaskStatusFailed  = "failed"
+)
+
+// AsyncTaskPolicy configures how the client waits for the async tasks Sentry
+// may spawn to create or update alert rules, for instance when it needs to
+// look up a Slack channel.
+type AsyncTaskPolicy struct {
+	// The time to wait before polling the task for the first time.
+	// It doubles after every poll. Defaults to 1s.
+	Interval time.Duration
+
+	// The upper bound of the time between polls. Defaults to 10s.
+	MaxInterval time.Duration
+
+	// The maximum time to wait for the task to finish. Defaults to 1m.
+	Timeout time.Duration
+}
+
+// WithAsyncTaskPolicy sets the policy used to wait for async tasks.
+func WithAsyncTaskPolicy(policy *AsyncTaskPolicy) ClientOption {
+	return func(c *Client) error {
+		c.AsyncTaskPolicy = policy
+		return nil
+	}
+}
+
+func (p *AsyncTaskPolicy) interval() time.Duration {
+	if p == nil || p.Interval <= 0 {
+		return defaultAsyncTaskInterval
+	}
+	return p.Interval
+}
+
+func (p *AsyncTaskPolicy) maxInterval() time.Duration {
+	if p == nil || p.MaxInterval <= 0 {
+		return defaultAsyncTaskMaxInterval
+	}
+	return p.MaxInterval
+}
+
+func (p *AsyncTaskPolicy) timeout() time.Duration {
+	if p == nil || p.Timeout <= 0 {
+		return defaultAsyncTaskTimeout
+	}
+	return p.Timeout
+}
+
+// AsyncTaskError is returned when an async task fails, succeeds without a
+// result, or does not finish before the policy timeout or the cancellation of
+// the context. In the latter case, Err is the context error.
+type AsyncTaskError struct {
+	TaskUUID string
+	Status   string
+	Detail   string
+	Err      error
+}
+
+func (e *AsyncTaskError) Error() string {
+	msg := fmt.Sprintf("sentry: async task %s: status %s", e.TaskUUID, e.Status)
+	if e.Detail != "" {
+		msg += ": " + e.Detail
+	}
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+	return msg
+}
+
+func (e *AsyncTaskError) Unwrap() error {
+	return e.Err
+}
+
+// asyncTaskPollFunc fetches the status of an async task, returning its result
+// once it succeeded or the error detail once it failed.
+type asyncTaskPollFunc[T any] func(ctx context.Context) (status *string, result *T, detail *string, resp *Response, err error)
+
+// pollAsyncTask polls an async task until it succeeds or fails, backing off
+// between polls according to the policy.
+func pollAsyncTask[T any](ctx context.Context, policy *AsyncTaskPolicy, taskUUID string, poll asyncTaskPollFunc[T]) (*T, *Response, error) {
+	pollCtx, cancel := context.WithTimeout(ctx, policy.timeout())
+	defer cancel()
+
+	var resp *Response
+	status := AsyncTaskStatusPending
+	interval := policy.interval()
+	for {
+		timer := time.NewTimer(interval)
+		select {
+		case <-pollCtx.Done():
+			timer.Stop()
+			return nil, resp, &AsyncTaskError{TaskUUID: taskUUID, Status: status, Err: pollCtx.Err()}
+		case <-timer.C:
+		}
+
+		s, result, detail, r, err := poll(pollCtx)
+		resp = r
+		if err != nil {
+			if pollCtx.Err() != nil {
+				return nil, resp, &AsyncTaskError{TaskUUID: taskUUID, Status: status, Err: pollCtx.Err()}
+			}
+			return nil, resp, err
+		}
+		if s != nil {
+			status = *s
+		}
+
+		switch status {
+		case AsyncTaskStatusSuccess:
+			if result == nil {
+				return nil, resp, &AsyncTaskError{TaskUUID: taskUUID, Status: status, Detail: "no result"}
+			}
+			return result, resp, nil
+		case AsyncTaskStatusFailed:
+			taskErr := &AsyncTaskError{TaskUUID: taskUUID, Status: status}
+			if detail != nil {
+				taskErr.Detail = *detail
+			}
+			return result, resp, taskErr
+		}
+
+		interval *= 2
+		if interval > policy.maxInterval() {
+			interval = policy.maxInterval()
+		}
+	}
+}
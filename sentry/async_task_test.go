@@ -0,0 +1,123 @@
+package sentry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAsyncTask_failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.AsyncTaskPolicy = &AsyncTaskPolicy{Interval: time.Millisecond}
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/alert-rule-task/fakeuuid/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"status": "failed", "error": "Could not find channel #alerts.", "alertRule": null}`)
+	})
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/alert-rules/12345/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"uuid": "fakeuuid"}`)
+	})
+
+	ctx := context.Background()
+	alert, _, err := client.MetricAlerts.Update(ctx, "the-interstellar-jurisdiction", "pump-station", "12345", &MetricAlert{Name: String("pump-station-alert")})
+	assert.Nil(t, alert)
+
+	var taskErr *AsyncTaskError
+	require.ErrorAs(t, err, &taskErr)
+	assert.Equal(t, &AsyncTaskError{
+		TaskUUID: "fakeuuid",
+		Status:   AsyncTaskStatusFailed,
+		Detail:   "Could not find channel #alerts.",
+	}, taskErr)
+	assert.EqualError(t, err, "sentry: async task fakeuuid: status failed: Could not find channel #alerts.")
+}
+
+func TestAsyncTask_successWithoutResult(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.AsyncTaskPolicy = &AsyncTaskPolicy{Interval: time.Millisecond}
+
+	polls := 0
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/rule-task/fakeuuid/", func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"status": "success", "error": null, "rule": null}`)
+	})
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/rules/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"uuid": "fakeuuid"}`)
+	})
+
+	ctx := context.Background()
+	alert, _, err := client.IssueAlerts.Create(ctx, "the-interstellar-jurisdiction", "pump-station", &IssueAlert{Name: String("Notify errors")})
+	assert.Nil(t, alert)
+	assert.Equal(t, 1, polls)
+
+	var taskErr *AsyncTaskError
+	require.ErrorAs(t, err, &taskErr)
+	assert.Equal(t, &AsyncTaskError{
+		TaskUUID: "fakeuuid",
+		Status:   AsyncTaskStatusSuccess,
+		Detail:   "no result",
+	}, taskErr)
+}
+
+func TestAsyncTask_timeout(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.AsyncTaskPolicy = &AsyncTaskPolicy{
+		Interval:    time.Millisecond,
+		MaxInterval: 5 * time.Millisecond,
+		Timeout:     50 * time.Millisecond,
+	}
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/rule-task/fakeuuid/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"status": "pending", "error": null, "rule": null}`)
+	})
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/rules/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"uuid": "fakeuuid"}`)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.IssueAlerts.Create(ctx, "the-interstellar-jurisdiction", "pump-station", &IssueAlert{Name: String("Notify errors")})
+
+	var taskErr *AsyncTaskError
+	require.ErrorAs(t, err, &taskErr)
+	assert.Equal(t, "fakeuuid", taskErr.TaskUUID)
+	assert.Equal(t, AsyncTaskStatusPending, taskErr.Status)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestAsyncTask_canceled(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/alert-rules/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"uuid": "fakeuuid"}`)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+	_, _, err := client.MetricAlerts.Create(ctx, "the-interstellar-jurisdiction", "pump-station", &MetricAlert{Name: String("pump-station-alert")})
+
+	var taskErr *AsyncTaskError
+	require.ErrorAs(t, err, &taskErr)
+	assert.Equal(t, "fakeuuid", taskErr.TaskUUID)
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,516 @@
+package sentry
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Chunk upload compression algorithms.
+const (
+	ChunkCompressionGzip = "gzip"
+)
+
+// Chunked file states reported by the assemble endpoints.
+const (
+	ChunkFileStateNotFound   = "not_found"
+	ChunkFileStateCreated    = "created"
+	ChunkFileStateAssembling = "assembling"
+	ChunkFileStateOK         = "ok"
+	ChunkFileStateError      = "error"
+)
+
+const (
+	defaultAssemblePollInterval = time.Second
+	defaultAssembleTimeout      = 5 * time.Minute
+)
+
+// ChunkUploadOptions represents the chunk upload settings of a Sentry server.
+// https://github.com/getsentry/sentry/blob/24.10.0/src/sentry/api/endpoints/chunk.py
+type ChunkUploadOptions struct {
+	URL              string   `json:"url"`
+	ChunkSize        int64    `json:"chunkSize"`
+	ChunksPerRequest int      `json:"chunksPerRequest"`
+	MaxFileSize      int64    `json:"maxFileSize"`
+	MaxRequestSize   int64    `json:"maxRequestSize"`
+	Concurrency      int      `json:"concurrency"`
+	HashAlgorithm    string   `json:"hashAlgorithm"`
+	Compression      []string `json:"compression"`
+	Accept           []string `json:"accept"`
+}
+
+func (o *ChunkUploadOptions) supportsCompression(algorithm string) bool {
+	for _, c := range o.Compression {
+		if c == algorithm {
+			return true
+		}
+	}
+	return false
+}
+
+// FileChunk is a chunk of a ChunkedFile.
+type FileChunk struct {
+	Checksum string
+	Data     []byte
+}
+
+// ChunkedFile is a file split into chunks for the chunk upload protocol.
+type ChunkedFile struct {
+	Checksum string
+	Size     int64
+	Chunks   []*FileChunk
+}
+
+// NewChunkedFile splits data into chunks of at most chunkSize bytes and
+// computes the SHA1 checksums of the file and its chunks. A chunkSize of
+// zero or less puts the whole file in a single chunk.
+func NewChunkedFile(data []byte, chunkSize int64) *ChunkedFile {
+	sum := sha1.Sum(data)
+	f := &ChunkedFile{
+		Checksum: hex.EncodeToString(sum[:]),
+		Size:     int64(len(data)),
+	}
+	if chunkSize <= 0 {
+		chunkSize = int64(len(data))
+	}
+	for start := int64(0); start < f.Size; start += chunkSize {
+		end := start + chunkSize
+		if end > f.Size {
+			end = f.Size
+		}
+		chunkSum := sha1.Sum(data[start:end])
+		f.Chunks = append(f.Chunks, &FileChunk{
+			Checksum: hex.EncodeToString(chunkSum[:]),
+			Data:     data[start:end],
+		})
+	}
+	return f
+}
+
+// ChunkChecksums returns the checksums of the chunks of the file, in order.
+func (f *ChunkedFile) ChunkChecksums() []string {
+	checksums := make([]string, len(f.Chunks))
+	for i, c := range f.Chunks {
+		checksums[i] = c.Checksum
+	}
+	return checksums
+}
+
+// ChunkAssembleResponse represents the state of a file being assembled from chunks.
+type ChunkAssembleResponse struct {
+	State         string   `json:"state"`
+	MissingChunks []string `json:"missingChunks"`
+	Detail        *string  `json:"detail"`
+}
+
+// ChunkAssembleError is returned when Sentry fails to assemble a file from its
+// chunks, or does not finish before the assemble timeout. In the latter case,
+// Err is context.DeadlineExceeded.
+type ChunkAssembleError struct {
+	Checksum string
+	State    string
+	Detail   string
+	Err      error
+}
+
+func (e *ChunkAssembleError) Error() string {
+	msg := fmt.Sprintf("sentry: assembling file %s: state %s", e.Checksum, e.State)
+	if e.Detail != "" {
+		msg += ": " + e.Detail
+	}
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+	return msg
+}
+
+func (e *ChunkAssembleError) Unwrap() error {
+	return e.Err
+}
+
+// ChunkUploadsService provides methods for uploading files to Sentry using
+// the chunk upload protocol: files are split into chunks which are uploaded
+// in parallel, then assembled server-side.
+// https://github.com/getsentry/sentry/blob/24.10.0/src/sentry/api/endpoints/chunk.py
+type ChunkUploadsService service
+
+// GetOptions returns the chunk upload settings of the server.
+func (s *ChunkUploadsService) GetOptions(ctx context.Context, organizationSlug string) (*ChunkUploadOptions, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/chunk-upload/", organizationSlug)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	options := new(ChunkUploadOptions)
+	resp, err := s.client.Do(ctx, req, options)
+	if err != nil {
+		return nil, resp, err
+	}
+	return options, resp, nil
+}
+
+// UploadChunks uploads chunks, batching them according to options and
+// sending up to options.Concurrency requests in parallel. Chunks are gzip
+// compressed if the server supports it.
+func (s *ChunkUploadsService) UploadChunks(ctx context.Context, organizationSlug string, options *ChunkUploadOptions, chunks []*FileChunk) error {
+	u := options.URL
+	if u == "" {
+		u = fmt.Sprintf("0/organizations/%v/chunk-upload/", organizationSlug)
+	}
+
+	fieldName := "file"
+	if options.supportsCompression(ChunkCompressionGzip) {
+		fieldName = "file_gzip"
+	}
+
+	parts := make([]*FileChunk, 0, len(chunks))
+	for _, chunk := range chunks {
+		data := chunk.Data
+		if fieldName == "file_gzip" {
+			var err error
+			if data, err = gzipBytes(data); err != nil {
+				return err
+			}
+		}
+		parts = append(parts, &FileChunk{Checksum: chunk.Checksum, Data: data})
+	}
+
+	concurrency := options.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	sem := make(chan struct{}, concurrency)
+
+loop:
+	for _, batch := range batchChunks(parts, options.ChunksPerRequest, options.MaxRequestSize) {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			break loop
+		}
+
+		wg.Add(1)
+		go func(batch []*FileChunk) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			form := &MultipartForm{}
+			for _, chunk := range batch {
+				form.AddFile(fieldName, chunk.Checksum, bytes.NewReader(chunk.Data))
+			}
+			req, err := s.client.NewRequest("POST", u, form)
+			if err == nil {
+				_, err = s.client.Do(ctx, req, nil)
+			}
+			if err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+			}
+		}(batch)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
+
+// batchChunks groups chunks into requests of at most chunksPerRequest chunks
+// and maxRequestSize bytes. Zero limits are ignored.
+func batchChunks(chunks []*FileChunk, chunksPerRequest int, maxRequestSize int64) [][]*FileChunk {
+	var batches [][]*FileChunk
+	var batch []*FileChunk
+	var size int64
+	for _, chunk := range chunks {
+		n := int64(len(chunk.Data))
+		full := chunksPerRequest > 0 && len(batch) >= chunksPerRequest
+		tooLarge := maxRequestSize > 0 && size+n > maxRequestSize
+		if len(batch) > 0 && (full || tooLarge) {
+			batches = append(batches, batch)
+			batch, size = nil, 0
+		}
+		batch = append(batch, chunk)
+		size += n
+	}
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+	return batches
+}
+
+func gzipBytes(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(data); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// assemble requests the assembly of file, uploading the chunks Sentry
+// reports missing, and polls until the file is assembled, fails or the
+// timeout elapses after the upload.
+func (s *ChunkUploadsService) assemble(ctx context.Context, organizationSlug string, options *ChunkUploadOptions, file *ChunkedFile, pollInterval time.Duration, timeout time.Duration, fn func(ctx context.Context) (*ChunkAssembleResponse, *Response, error)) (*Response, error) {
+	if pollInterval <= 0 {
+		pollInterval = defaultAssemblePollInterval
+	}
+	if timeout <= 0 {
+		timeout = defaultAssembleTimeout
+	}
+
+	uploaded := false
+	deadline := time.Now().Add(timeout)
+	for {
+		result, resp, err := fn(ctx)
+		if err != nil {
+			return resp, err
+		}
+
+		missing := result.MissingChunks
+		if !uploaded && len(missing) == 0 && result.State == ChunkFileStateNotFound {
+			missing = file.ChunkChecksums()
+		}
+		if len(missing) > 0 {
+			if uploaded {
+				return resp, &ChunkAssembleError{Checksum: file.Checksum, State: result.State, Detail: "chunks missing after upload"}
+			}
+			if err := s.UploadChunks(ctx, organizationSlug, options, missingChunks(file, missing)); err != nil {
+				return resp, err
+			}
+			uploaded = true
+			deadline = time.Now().Add(timeout)
+			continue
+		}
+
+		switch result.State {
+		case ChunkFileStateOK:
+			return resp, nil
+		case ChunkFileStateError:
+			detail := ""
+			if result.Detail != nil {
+				detail = *result.Detail
+			}
+			return resp, &ChunkAssembleError{Checksum: file.Checksum, State: result.State, Detail: detail}
+		case ChunkFileStateNotFound:
+			// Sentry lost track of the file although every chunk was uploaded.
+			return resp, &ChunkAssembleError{Checksum: file.Checksum, State: result.State, Detail: "file not found after upload"}
+		}
+
+		wait := time.Until(deadline)
+		if wait <= 0 {
+			return resp, &ChunkAssembleError{Checksum: file.Checksum, State: result.State, Err: context.DeadlineExceeded}
+		}
+		if wait > pollInterval {
+			wait = pollInterval
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return resp, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func missingChunks(file *ChunkedFile, checksums []string) []*FileChunk {
+	missing := make(map[string]bool, len(checksums))
+	for _, c := range checksums {
+		missing[c] = true
+	}
+	chunks := []*FileChunk{}
+	for _, chunk := range file.Chunks {
+		if missing[chunk.Checksum] {
+			chunks = append(chunks, chunk)
+			// Identical chunks only need to be uploaded once.
+			delete(missing, chunk.Checksum)
+		}
+	}
+	return chunks
+}
+
+// AssembleArtifactBundleParams are the parameters for ChunkUploadsService.AssembleArtifactBundle.
+type AssembleArtifactBundleParams struct {
+	Checksum string   `json:"checksum"`
+	Chunks   []string `json:"chunks"`
+	Projects []string `json:"projects"`
+	Version  *string  `json:"version,omitempty"`
+	Dist     *string  `json:"dist,omitempty"`
+}
+
+// AssembleArtifactBundle requests the assembly of an artifact bundle from
+// uploaded chunks and returns its current state.
+func (s *ChunkUploadsService) AssembleArtifactBundle(ctx context.Context, organizationSlug string, params *AssembleArtifactBundleParams) (*ChunkAssembleResponse, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/artifactbundle/assemble/", organizationSlug)
+	req, err := s.client.NewRequest("POST", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(ChunkAssembleResponse)
+	resp, err := s.client.Do(ctx, req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return result, resp, nil
+}
+
+// UploadArtifactBundleParams are the parameters for ChunkUploadsService.UploadArtifactBundle.
+type UploadArtifactBundleParams struct {
+	Projects []string
+	Version  *string
+	Dist     *string
+
+	// PollInterval is the time between assemble requests while the bundle
+	// is being assembled. Defaults to one second.
+	PollInterval time.Duration
+
+	// Timeout is the maximum time to wait for the bundle to be assembled
+	// once uploaded. Defaults to 5 minutes.
+	Timeout time.Duration
+}
+
+// UploadArtifactBundle uploads an artifact bundle, a zip archive of source
+// files and source maps with a manifest.json, and waits for Sentry to
+// assemble it. Use the context to bound the whole upload.
+// Empty bundles are refused, as Sentry cannot assemble a file without chunks.
+func (s *ChunkUploadsService) UploadArtifactBundle(ctx context.Context, organizationSlug string, bundle []byte, params *UploadArtifactBundleParams) (*ChunkAssembleResponse, *Response, error) {
+	if len(bundle) == 0 {
+		return nil, nil, errors.New("sentry: cannot upload an empty artifact bundle")
+	}
+	if params == nil {
+		params = &UploadArtifactBundleParams{}
+	}
+
+	options, resp, err := s.GetOptions(ctx, organizationSlug)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	file := NewChunkedFile(bundle, options.ChunkSize)
+	assembleParams := &AssembleArtifactBundleParams{
+		Checksum: file.Checksum,
+		Chunks:   file.ChunkChecksums(),
+		Projects: params.Projects,
+		Version:  params.Version,
+		Dist:     params.Dist,
+	}
+
+	var result *ChunkAssembleResponse
+	resp, err = s.assemble(ctx, organizationSlug, options, file, params.PollInterval, params.Timeout, func(ctx context.Context) (*ChunkAssembleResponse, *Response, error) {
+		r, resp, err := s.AssembleArtifactBundle(ctx, organizationSlug, assembleParams)
+		if r != nil {
+			result = r
+		}
+		return r, resp, err
+	})
+	return result, resp, err
+}
+
+// AssembleDebugFileParams describes a debug information file to assemble
+// from uploaded chunks.
+type AssembleDebugFileParams struct {
+	Name    string   `json:"name"`
+	DebugID *string  `json:"debug_id,omitempty"`
+	Chunks  []string `json:"chunks"`
+}
+
+// DebugFileAssembleResponse represents the state of a debug information
+// file being assembled from chunks.
+type DebugFileAssembleResponse struct {
+	ChunkAssembleResponse
+	Dif *DebugFile `json:"dif"`
+}
+
+// AssembleDebugFiles requests the assembly of debug information files from
+// uploaded chunks. Both files and results are keyed by file checksum.
+func (s *ChunkUploadsService) AssembleDebugFiles(ctx context.Context, organizationSlug string, projectSlug string, files map[string]*AssembleDebugFileParams) (map[string]*DebugFileAssembleResponse, *Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/files/difs/assemble/", organizationSlug, projectSlug)
+	req, err := s.client.NewRequest("POST", u, files)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	results := map[string]*DebugFileAssembleResponse{}
+	resp, err := s.client.Do(ctx, req, &results)
+	if err != nil {
+		return nil, resp, err
+	}
+	return results, resp, nil
+}
+
+// UploadDebugFileParams are the parameters for ChunkUploadsService.UploadDebugFile.
+type UploadDebugFileParams struct {
+	Name    string
+	DebugID *string
+
+	// PollInterval is the time between assemble requests while the file
+	// is being assembled. Defaults to one second.
+	PollInterval time.Duration
+
+	// Timeout is the maximum time to wait for the file to be assembled
+	// once uploaded. Defaults to 5 minutes.
+	Timeout time.Duration
+}
+
+// UploadDebugFile uploads a debug information file and waits for Sentry to
+// assemble it. Use the context to bound the whole upload.
+// Empty files are refused, as Sentry cannot assemble a file without chunks.
+func (s *ChunkUploadsService) UploadDebugFile(ctx context.Context, organizationSlug string, projectSlug string, data []byte, params *UploadDebugFileParams) (*DebugFileAssembleResponse, *Response, error) {
+	if len(data) == 0 {
+		return nil, nil, errors.New("sentry: cannot upload an empty debug file")
+	}
+	if params == nil {
+		params = &UploadDebugFileParams{}
+	}
+
+	options, resp, err := s.GetOptions(ctx, organizationSlug)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	file := NewChunkedFile(data, options.ChunkSize)
+	files := map[string]*AssembleDebugFileParams{
+		file.Checksum: {
+			Name:    params.Name,
+			DebugID: params.DebugID,
+			Chunks:  file.ChunkChecksums(),
+		},
+	}
+
+	var result *DebugFileAssembleResponse
+	resp, err = s.assemble(ctx, organizationSlug, options, file, params.PollInterval, params.Timeout, func(ctx context.Context) (*ChunkAssembleResponse, *Response, error) {
+		results, resp, err := s.AssembleDebugFiles(ctx, organizationSlug, projectSlug, files)
+		if err != nil {
+			return nil, resp, err
+		}
+		result = results[file.Checksum]
+		if result == nil {
+			result = &DebugFileAssembleResponse{ChunkAssembleResponse: ChunkAssembleResponse{State: ChunkFileStateNotFound}}
+		}
+		return &result.ChunkAssembleResponse, resp, nil
+	})
+	return result, resp, err
+}
@@ -0,0 +1,371 @@
+package sentry
+
+import (
+	"compress/gzip"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewChunkedFile(t *testing.T) {
+	file := NewChunkedFile([]byte("hello world"), 6)
+
+	assert.Equal(t, "2aae6c35c94fcfb415dbe95f408b9ce91ee846ed", file.Checksum)
+	assert.Equal(t, int64(11), file.Size)
+	assert.Equal(t, []*FileChunk{
+		{Checksum: "c4d871ad13ad00fde9a7bb7ff7ed2543aec54241", Data: []byte("hello ")},
+		{Checksum: "7c211433f02071597741e6ff5a8ea34789abbf43", Data: []byte("world")},
+	}, file.Chunks)
+	assert.Equal(t, []string{file.Chunks[0].Checksum, file.Chunks[1].Checksum}, file.ChunkChecksums())
+
+	single := NewChunkedFile([]byte("hello world"), 0)
+	assert.Len(t, single.Chunks, 1)
+	assert.Equal(t, single.Checksum, single.Chunks[0].Checksum)
+}
+
+func TestBatchChunks(t *testing.T) {
+	chunks := []*FileChunk{
+		{Checksum: "a", Data: make([]byte, 4)},
+		{Checksum: "b", Data: make([]byte, 4)},
+		{Checksum: "c", Data: make([]byte, 4)},
+		{Checksum: "d", Data: make([]byte, 4)},
+		{Checksum: "e", Data: make([]byte, 4)},
+	}
+
+	checksums := func(batches [][]*FileChunk) [][]string {
+		var out [][]string
+		for _, batch := range batches {
+			var b []string
+			for _, c := range batch {
+				b = append(b, c.Checksum)
+			}
+			out = append(out, b)
+		}
+		return out
+	}
+
+	assert.Equal(t, [][]string{{"a", "b"}, {"c", "d"}, {"e"}}, checksums(batchChunks(chunks, 2, 0)))
+	assert.Equal(t, [][]string{{"a", "b", "c"}, {"d", "e"}}, checksums(batchChunks(chunks, 0, 12)))
+	assert.Equal(t, [][]string{{"a"}, {"b"}, {"c"}, {"d"}, {"e"}}, checksums(batchChunks(chunks, 4, 3)))
+	assert.Equal(t, [][]string{{"a", "b", "c", "d", "e"}}, checksums(batchChunks(chunks, 0, 0)))
+}
+
+func TestChunkUploadsService_GetOptions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/chunk-upload/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"url": "https://sentry.io/api/0/organizations/the-interstellar-jurisdiction/chunk-upload/",
+			"chunkSize": 8388608,
+			"chunksPerRequest": 64,
+			"maxFileSize": 2147483648,
+			"maxRequestSize": 33554432,
+			"concurrency": 8,
+			"hashAlgorithm": "sha1",
+			"compression": ["gzip"],
+			"accept": ["debug_files", "release_files", "artifact_bundles"]
+		}`)
+	})
+
+	ctx := context.Background()
+	options, _, err := client.ChunkUploads.GetOptions(ctx, "the-interstellar-jurisdiction")
+	assert.NoError(t, err)
+
+	expected := &ChunkUploadOptions{
+		URL:              "https://sentry.io/api/0/organizations/the-interstellar-jurisdiction/chunk-upload/",
+		ChunkSize:        8388608,
+		ChunksPerRequest: 64,
+		MaxFileSize:      2147483648,
+		MaxRequestSize:   33554432,
+		Concurrency:      8,
+		HashAlgorithm:    "sha1",
+		Compression:      []string{"gzip"},
+		Accept:           []string{"debug_files", "release_files", "artifact_bundles"},
+	}
+	assert.Equal(t, expected, options)
+}
+
+// chunkServer records the chunks uploaded to the chunk upload endpoint.
+type chunkServer struct {
+	mu       sync.Mutex
+	chunks   map[string][]byte
+	requests int
+}
+
+func (cs *chunkServer) handler(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{
+				"url": "http://%s/api/0/organizations/the-interstellar-jurisdiction/chunk-upload/",
+				"chunkSize": 4,
+				"chunksPerRequest": 2,
+				"concurrency": 2,
+				"hashAlgorithm": "sha1",
+				"compression": ["gzip"]
+			}`, r.Host)
+			return
+		}
+
+		assertMethod(t, "POST", r)
+		if !assert.NoError(t, r.ParseMultipartForm(1<<20)) {
+			return
+		}
+		assert.Empty(t, r.MultipartForm.File["file"])
+		assert.LessOrEqual(t, len(r.MultipartForm.File["file_gzip"]), 2)
+
+		cs.mu.Lock()
+		defer cs.mu.Unlock()
+		cs.requests++
+		for _, fh := range r.MultipartForm.File["file_gzip"] {
+			f, err := fh.Open()
+			if !assert.NoError(t, err) {
+				return
+			}
+			zr, err := gzip.NewReader(f)
+			if !assert.NoError(t, err) {
+				return
+			}
+			data, err := io.ReadAll(zr)
+			assert.NoError(t, err)
+			f.Close()
+
+			sum := sha1.Sum(data)
+			assert.Equal(t, fh.Filename, hex.EncodeToString(sum[:]))
+			cs.chunks[fh.Filename] = data
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+func (cs *chunkServer) uploaded() []string {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	checksums := make([]string, 0, len(cs.chunks))
+	for c := range cs.chunks {
+		checksums = append(checksums, c)
+	}
+	sort.Strings(checksums)
+	return checksums
+}
+
+func TestChunkUploadsService_UploadArtifactBundle(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	bundle := []byte("PK\x03\x04 artifact bundle")
+	file := NewChunkedFile(bundle, 4)
+
+	cs := &chunkServer{chunks: map[string][]byte{}}
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/chunk-upload/", cs.handler(t))
+
+	assembleCalls := 0
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/artifactbundle/assemble/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assertPostJSON(t, map[string]interface{}{
+			"checksum": file.Checksum,
+			"chunks":   stringsToInterfaces(file.ChunkChecksums()),
+			"projects": []interface{}{"pump-station"},
+			"version":  "1.0.0",
+		}, r)
+		assembleCalls++
+		w.Header().Set("Content-Type", "application/json")
+		switch assembleCalls {
+		case 1:
+			missing, _ := json.Marshal(file.ChunkChecksums()[1:])
+			fmt.Fprintf(w, `{"state": "not_found", "missingChunks": %s}`, missing)
+		case 2:
+			fmt.Fprint(w, `{"state": "created", "missingChunks": []}`)
+		case 3:
+			fmt.Fprint(w, `{"state": "assembling", "missingChunks": []}`)
+		default:
+			fmt.Fprint(w, `{"state": "ok", "missingChunks": [], "detail": null}`)
+		}
+	})
+
+	ctx := context.Background()
+	result, _, err := client.ChunkUploads.UploadArtifactBundle(ctx, "the-interstellar-jurisdiction", bundle, &UploadArtifactBundleParams{
+		Projects:     []string{"pump-station"},
+		Version:      String("1.0.0"),
+		PollInterval: time.Millisecond,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &ChunkAssembleResponse{State: ChunkFileStateOK, MissingChunks: []string{}}, result)
+	assert.Equal(t, 4, assembleCalls)
+
+	expected := file.ChunkChecksums()[1:]
+	sort.Strings(expected)
+	assert.Equal(t, expected, cs.uploaded())
+	// 4 missing chunks, 2 chunks per request.
+	assert.Equal(t, 2, cs.requests)
+}
+
+func TestChunkUploadsService_UploadDebugFile(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	data := []byte("\x7fELF debug file")
+	file := NewChunkedFile(data, 4)
+
+	cs := &chunkServer{chunks: map[string][]byte{}}
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/chunk-upload/", cs.handler(t))
+
+	assembleCalls := 0
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/files/difs/assemble/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assertPostJSON(t, map[string]interface{}{
+			file.Checksum: map[string]interface{}{
+				"name":     "app.debug",
+				"debug_id": "5f0c9d0e-3e4e-4e8b-9c2e-2b9c1f0a9f3e",
+				"chunks":   stringsToInterfaces(file.ChunkChecksums()),
+			},
+		}, r)
+		assembleCalls++
+		w.Header().Set("Content-Type", "application/json")
+		if assembleCalls == 1 {
+			fmt.Fprintf(w, `{%q: {"state": "not_found", "missingChunks": []}}`, file.Checksum)
+			return
+		}
+		fmt.Fprintf(w, `{%q: {"state": "ok", "missingChunks": [], "dif": {"id": "1", "objectName": "app.debug"}}}`, file.Checksum)
+	})
+
+	ctx := context.Background()
+	result, _, err := client.ChunkUploads.UploadDebugFile(ctx, "the-interstellar-jurisdiction", "pump-station", data, &UploadDebugFileParams{
+		Name:         "app.debug",
+		DebugID:      String("5f0c9d0e-3e4e-4e8b-9c2e-2b9c1f0a9f3e"),
+		PollInterval: time.Millisecond,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, ChunkFileStateOK, result.State)
+	assert.Equal(t, &DebugFile{ID: String("1"), ObjectName: String("app.debug")}, result.Dif)
+	assert.Equal(t, 2, assembleCalls)
+
+	// A not_found state without missing chunks uploads the whole file.
+	expected := file.ChunkChecksums()
+	sort.Strings(expected)
+	assert.Equal(t, expected, cs.uploaded())
+}
+
+func TestChunkUploadsService_UploadDebugFile_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	data := []byte("not a debug file")
+	file := NewChunkedFile(data, 4)
+
+	cs := &chunkServer{chunks: map[string][]byte{}}
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/chunk-upload/", cs.handler(t))
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/files/difs/assemble/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{%q: {"state": "error", "missingChunks": [], "detail": "Unsupported debug information file"}}`, file.Checksum)
+	})
+
+	ctx := context.Background()
+	result, _, err := client.ChunkUploads.UploadDebugFile(ctx, "the-interstellar-jurisdiction", "pump-station", data, &UploadDebugFileParams{
+		Name: "app.debug",
+	})
+	assert.Equal(t, &ChunkAssembleError{
+		Checksum: file.Checksum,
+		State:    ChunkFileStateError,
+		Detail:   "Unsupported debug information file",
+	}, err)
+	assert.Equal(t, ChunkFileStateError, result.State)
+	assert.Empty(t, cs.uploaded())
+}
+
+func TestChunkUploadsService_UploadDebugFile_notFoundAfterUpload(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	data := []byte("\x7fELF debug file")
+	file := NewChunkedFile(data, 4)
+
+	cs := &chunkServer{chunks: map[string][]byte{}}
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/chunk-upload/", cs.handler(t))
+
+	assembleCalls := 0
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/files/difs/assemble/", func(w http.ResponseWriter, r *http.Request) {
+		assembleCalls++
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{%q: {"state": "not_found", "missingChunks": []}}`, file.Checksum)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.ChunkUploads.UploadDebugFile(ctx, "the-interstellar-jurisdiction", "pump-station", data, &UploadDebugFileParams{
+		Name:         "app.debug",
+		PollInterval: time.Millisecond,
+	})
+	assert.Equal(t, &ChunkAssembleError{
+		Checksum: file.Checksum,
+		State:    ChunkFileStateNotFound,
+		Detail:   "file not found after upload",
+	}, err)
+	assert.Equal(t, 2, assembleCalls)
+	assert.Len(t, cs.uploaded(), len(file.Chunks))
+}
+
+func TestChunkUploadsService_emptyFile(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/chunk-upload/", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected request")
+	})
+
+	ctx := context.Background()
+	_, _, err := client.ChunkUploads.UploadArtifactBundle(ctx, "the-interstellar-jurisdiction", nil, nil)
+	assert.EqualError(t, err, "sentry: cannot upload an empty artifact bundle")
+	_, _, err = client.ChunkUploads.UploadDebugFile(ctx, "the-interstellar-jurisdiction", "pump-station", []byte{}, &UploadDebugFileParams{Name: "app.debug"})
+	assert.EqualError(t, err, "sentry: cannot upload an empty debug file")
+}
+
+func TestChunkUploadsService_UploadArtifactBundle_timeout(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	bundle := []byte("PK\x03\x04 artifact bundle")
+	file := NewChunkedFile(bundle, 4)
+
+	cs := &chunkServer{chunks: map[string][]byte{}}
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/chunk-upload/", cs.handler(t))
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/artifactbundle/assemble/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"state": "assembling", "missingChunks": []}`)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.ChunkUploads.UploadArtifactBundle(ctx, "the-interstellar-jurisdiction", bundle, &UploadArtifactBundleParams{
+		Projects:     []string{"pump-station"},
+		PollInterval: time.Millisecond,
+		Timeout:      20 * time.Millisecond,
+	})
+
+	var assembleErr *ChunkAssembleError
+	require.ErrorAs(t, err, &assembleErr)
+	assert.Equal(t, file.Checksum, assembleErr.Checksum)
+	assert.Equal(t, ChunkFileStateAssembling, assembleErr.State)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func stringsToInterfaces(s []string) []interface{} {
+	out := make([]interface{}, len(s))
+	for i, v := range s {
+		out[i] = v
+	}
+	return out
+}
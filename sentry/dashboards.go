@@ -51,6 +51,13 @@ func (s *DashboardsService) List(ctx context.Context, organizationSlug string, p
 	return dashboards, resp, nil
 }
 
+// ListAll returns all dashboards in an organization, following pagination.
+func (s *DashboardsService) ListAll(ctx context.Context, organizationSlug string) ([]*Dashboard, *Response, error) {
+	return NewPager(func(ctx context.Context, cursor string) ([]*Dashboard, *Response, error) {
+		return s.List(ctx, organizationSlug, &ListCursorParams{Cursor: cursor})
+	}).All(ctx)
+}
+
 // Get details on a dashboard.
 func (s *DashboardsService) Get(ctx context.Context, organizationSlug string, id string) (*Dashboard, *Response, error) {
 	u := fmt.Sprintf("0/organizations/%v/dashboards/%v/", organizationSlug, id)
@@ -0,0 +1,146 @@
+package sentry
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Debug file features.
+const (
+	DebugFileFeatureSymtab  = "symtab"
+	DebugFileFeatureDebug   = "debug"
+	DebugFileFeatureUnwind  = "unwind"
+	DebugFileFeatureSources = "sources"
+)
+
+// DebugFile represents a debug information file (DIF) stored in Sentry.
+// https://github.com/getsentry/sentry/blob/24.10.0/src/sentry/api/serializers/models/debug_file.py
+type DebugFile struct {
+	ID          *string           `json:"id,omitempty"`
+	UUID        *string           `json:"uuid,omitempty"`
+	DebugID     *string           `json:"debugId,omitempty"`
+	CodeID      *string           `json:"codeId,omitempty"`
+	CPUName     *string           `json:"cpuName,omitempty"`
+	ObjectName  *string           `json:"objectName,omitempty"`
+	SymbolType  *string           `json:"symbolType,omitempty"`
+	Headers     map[string]string `json:"headers,omitempty"`
+	Size        *int              `json:"size,omitempty"`
+	SHA1        *string           `json:"sha1,omitempty"`
+	DateCreated *time.Time        `json:"dateCreated,omitempty"`
+	Data        *DebugFileData    `json:"data,omitempty"`
+}
+
+// DebugFileData holds the object type and features of a debug file.
+type DebugFileData struct {
+	Type     *string  `json:"type,omitempty"`
+	Features []string `json:"features,omitempty"`
+}
+
+// Features returns the features of the debug file, such as "symtab",
+// "debug", "unwind" and "sources".
+func (f *DebugFile) Features() []string {
+	if f.Data == nil {
+		return nil
+	}
+	return f.Data.Features
+}
+
+// HasFeature reports whether the debug file has the given feature.
+func (f *DebugFile) HasFeature(feature string) bool {
+	for _, v := range f.Features() {
+		if v == feature {
+			return true
+		}
+	}
+	return false
+}
+
+// DebugFilesService provides methods for accessing Sentry debug information file API endpoints.
+// https://docs.sentry.io/api/projects/list-a-projects-debug-information-files/
+type DebugFilesService service
+
+// ListDebugFilesParams are the parameters for DebugFilesService.List.
+type ListDebugFilesParams struct {
+	ListCursorParams
+
+	// Query searches debug ID, code ID, object name and CPU name.
+	Query       *string  `url:"query,omitempty"`
+	DebugID     *string  `url:"debug_id,omitempty"`
+	CodeID      *string  `url:"code_id,omitempty"`
+	FileFormats []string `url:"file_formats,omitempty"`
+}
+
+// List the debug information files of a project.
+// https://docs.sentry.io/api/projects/list-a-projects-debug-information-files/
+func (s *DebugFilesService) List(ctx context.Context, organizationSlug string, projectSlug string, params *ListDebugFilesParams) ([]*DebugFile, *Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/files/dsyms/", organizationSlug, projectSlug)
+	u, err := addQuery(u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	files := []*DebugFile{}
+	resp, err := s.client.Do(ctx, req, &files)
+	if err != nil {
+		return nil, resp, err
+	}
+	return files, resp, nil
+}
+
+// ListAll returns all debug information files of a project, following pagination.
+func (s *DebugFilesService) ListAll(ctx context.Context, organizationSlug string, projectSlug string, params *ListDebugFilesParams) ([]*DebugFile, *Response, error) {
+	p := ListDebugFilesParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewPager(func(ctx context.Context, cursor string) ([]*DebugFile, *Response, error) {
+		p.Cursor = cursor
+		return s.List(ctx, organizationSlug, projectSlug, &p)
+	}).All(ctx)
+}
+
+// ListByDebugID returns the debug information files of a project with the
+// given debug ID. A debug ID may match several files, such as an executable
+// and its separate debug companion, and none if symbols are missing.
+func (s *DebugFilesService) ListByDebugID(ctx context.Context, organizationSlug string, projectSlug string, debugID string) ([]*DebugFile, *Response, error) {
+	return s.ListAll(ctx, organizationSlug, projectSlug, &ListDebugFilesParams{
+		DebugID: String(debugID),
+	})
+}
+
+// Upload a debug information file using the chunk upload protocol and wait
+// for Sentry to process it. Use the context to bound the wait.
+func (s *DebugFilesService) Upload(ctx context.Context, organizationSlug string, projectSlug string, data []byte, params *UploadDebugFileParams) (*DebugFile, *Response, error) {
+	result, resp, err := (*ChunkUploadsService)(s).UploadDebugFile(ctx, organizationSlug, projectSlug, data, params)
+	if err != nil {
+		return nil, resp, err
+	}
+	return result.Dif, resp, nil
+}
+
+type deleteDebugFileParams struct {
+	ID string `url:"id"`
+}
+
+// Delete a debug information file of a project.
+// https://docs.sentry.io/api/projects/delete-a-specific-projects-debug-information-file/
+func (s *DebugFilesService) Delete(ctx context.Context, organizationSlug string, projectSlug string, id string) (*Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/files/dsyms/", organizationSlug, projectSlug)
+	u, err := addQuery(u, &deleteDebugFileParams{ID: id})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
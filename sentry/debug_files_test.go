@@ -0,0 +1,166 @@
+package sentry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDebugFilesService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/files/dsyms/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assert.Equal(t, url.Values{
+			"query":        {"libfoo"},
+			"file_formats": {"elf", "breakpad"},
+		}, r.URL.Query())
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[
+			{
+				"id": "1",
+				"uuid": "5f0c9d0e-3e4e-4e8b-9c2e-2b9c1f0a9f3e",
+				"debugId": "5f0c9d0e-3e4e-4e8b-9c2e-2b9c1f0a9f3e",
+				"codeId": "0e9d0c5f4e3e8b4e9c2e2b9c1f0a9f3e",
+				"cpuName": "x86_64",
+				"objectName": "libfoo.so",
+				"symbolType": "elf",
+				"headers": {"Content-Type": "text/x-elf-binary"},
+				"size": 4096,
+				"sha1": "2aae6c35c94fcfb415dbe95f408b9ce91ee846ed",
+				"dateCreated": "2024-01-01T00:00:00Z",
+				"data": {
+					"type": "dbg",
+					"features": ["debug", "symtab", "unwind"]
+				}
+			}
+		]`)
+	})
+
+	ctx := context.Background()
+	files, _, err := client.DebugFiles.List(ctx, "the-interstellar-jurisdiction", "pump-station", &ListDebugFilesParams{
+		Query:       String("libfoo"),
+		FileFormats: []string{"elf", "breakpad"},
+	})
+	assert.NoError(t, err)
+
+	expected := []*DebugFile{
+		{
+			ID:          String("1"),
+			UUID:        String("5f0c9d0e-3e4e-4e8b-9c2e-2b9c1f0a9f3e"),
+			DebugID:     String("5f0c9d0e-3e4e-4e8b-9c2e-2b9c1f0a9f3e"),
+			CodeID:      String("0e9d0c5f4e3e8b4e9c2e2b9c1f0a9f3e"),
+			CPUName:     String("x86_64"),
+			ObjectName:  String("libfoo.so"),
+			SymbolType:  String("elf"),
+			Headers:     map[string]string{"Content-Type": "text/x-elf-binary"},
+			Size:        Int(4096),
+			SHA1:        String("2aae6c35c94fcfb415dbe95f408b9ce91ee846ed"),
+			DateCreated: Time(mustParseTime("2024-01-01T00:00:00Z")),
+			Data: &DebugFileData{
+				Type:     String("dbg"),
+				Features: []string{"debug", "symtab", "unwind"},
+			},
+		},
+	}
+	assert.Equal(t, expected, files)
+
+	assert.Equal(t, []string{"debug", "symtab", "unwind"}, files[0].Features())
+	assert.True(t, files[0].HasFeature(DebugFileFeatureUnwind))
+	assert.False(t, files[0].HasFeature(DebugFileFeatureSources))
+	assert.False(t, (&DebugFile{}).HasFeature(DebugFileFeatureDebug))
+}
+
+func TestDebugFilesService_ListByDebugID(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/files/dsyms/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assert.Equal(t, "5f0c9d0e-3e4e-4e8b-9c2e-2b9c1f0a9f3e", r.URL.Query().Get("debug_id"))
+		w.Header().Set("Content-Type", "application/json")
+		u := serverURL + "/api/0/projects/the-interstellar-jurisdiction/pump-station/files/dsyms/"
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			w.Header().Set("Link", fmt.Sprintf(`<%s?&cursor=100:1:0>; rel="next"; results="true"; cursor="100:1:0"`, u))
+			fmt.Fprint(w, `[{"id": "1", "objectName": "libfoo.so"}]`)
+		case "100:1:0":
+			w.Header().Set("Link", fmt.Sprintf(`<%s?&cursor=100:2:0>; rel="next"; results="false"; cursor="100:2:0"`, u))
+			fmt.Fprint(w, `[{"id": "2", "objectName": "libfoo.so.debug"}]`)
+		}
+	})
+
+	ctx := context.Background()
+	files, _, err := client.DebugFiles.ListByDebugID(ctx, "the-interstellar-jurisdiction", "pump-station", "5f0c9d0e-3e4e-4e8b-9c2e-2b9c1f0a9f3e")
+	assert.NoError(t, err)
+
+	expected := []*DebugFile{
+		{ID: String("1"), ObjectName: String("libfoo.so")},
+		{ID: String("2"), ObjectName: String("libfoo.so.debug")},
+	}
+	assert.Equal(t, expected, files)
+}
+
+func TestDebugFilesService_Upload(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	data := []byte("\x7fELF")
+	file := NewChunkedFile(data, 0)
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/chunk-upload/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"chunkSize": 8388608, "chunksPerRequest": 64, "concurrency": 1, "hashAlgorithm": "sha1"}`)
+	})
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/files/difs/assemble/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{%q: {
+			"state": "ok",
+			"missingChunks": [],
+			"dif": {
+				"id": "1",
+				"debugId": "5f0c9d0e-3e4e-4e8b-9c2e-2b9c1f0a9f3e",
+				"objectName": "libfoo.so",
+				"data": {"features": ["symtab"]}
+			}
+		}}`, file.Checksum)
+	})
+
+	ctx := context.Background()
+	dif, _, err := client.DebugFiles.Upload(ctx, "the-interstellar-jurisdiction", "pump-station", data, &UploadDebugFileParams{
+		Name:         "libfoo.so",
+		PollInterval: time.Millisecond,
+	})
+	assert.NoError(t, err)
+
+	expected := &DebugFile{
+		ID:         String("1"),
+		DebugID:    String("5f0c9d0e-3e4e-4e8b-9c2e-2b9c1f0a9f3e"),
+		ObjectName: String("libfoo.so"),
+		Data:       &DebugFileData{Features: []string{"symtab"}},
+	}
+	assert.Equal(t, expected, dif)
+}
+
+func TestDebugFilesService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/files/dsyms/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		assertQuery(t, map[string]string{"id": "1"}, r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.DebugFiles.Delete(ctx, "the-interstellar-jurisdiction", "pump-station", "1")
+	assert.NoError(t, err)
+}
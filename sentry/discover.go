@@ -0,0 +1,276 @@
+package sentry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Discover datasets.
+const (
+	DiscoverDatasetDiscover     = "discover"
+	DiscoverDatasetErrors       = "errors"
+	DiscoverDatasetTransactions = "transactions"
+	DiscoverDatasetSpans        = "spans"
+)
+
+// DiscoverService provides methods for querying events with Sentry's Discover API.
+// https://docs.sentry.io/api/discover/
+type DiscoverService service
+
+// DiscoverMeta describes the columns of a Discover result: the type of each
+// field, such as "integer" or "duration", and its unit, such as "millisecond".
+type DiscoverMeta struct {
+	Fields        map[string]string  `json:"fields,omitempty"`
+	Units         map[string]*string `json:"units,omitempty"`
+	IsMetricsData *bool              `json:"isMetricsData,omitempty"`
+	Dataset       *string            `json:"dataset,omitempty"`
+}
+
+// DiscoverQueryResult represents the result of a Discover query. Each row
+// maps a requested field to its value, with numbers decoded as json.Number.
+type DiscoverQueryResult struct {
+	Data []map[string]interface{} `json:"data"`
+	Meta *DiscoverMeta            `json:"meta,omitempty"`
+}
+
+// DiscoverQueryParams are the parameters for DiscoverService.Query.
+type DiscoverQueryParams struct {
+	ListCursorParams
+
+	// Field lists the columns and aggregates to query, such as
+	// "transaction" or "p95(transaction.duration)".
+	Field []string `url:"field,omitempty"`
+	Query *string  `url:"query,omitempty"`
+	// Sort lists fields to sort by, prefixed with "-" for descending order.
+	Sort        []string   `url:"sort,omitempty"`
+	PerPage     *int       `url:"per_page,omitempty"`
+	Dataset     *string    `url:"dataset,omitempty"`
+	Project     []int      `url:"project,omitempty"`
+	Environment []string   `url:"environment,omitempty"`
+	StatsPeriod *string    `url:"statsPeriod,omitempty"`
+	Start       *time.Time `url:"start,omitempty"`
+	End         *time.Time `url:"end,omitempty"`
+}
+
+// NewDiscoverQueryParams returns the Discover query parameters matching a
+// dashboard widget query.
+func NewDiscoverQueryParams(q *DashboardWidgetQuery) *DiscoverQueryParams {
+	params := &DiscoverQueryParams{
+		Field: widgetQueryFields(q),
+		Query: q.Conditions,
+	}
+	if q.OrderBy != nil && *q.OrderBy != "" {
+		params.Sort = []string{*q.OrderBy}
+	}
+	return params
+}
+
+func widgetQueryFields(q *DashboardWidgetQuery) []string {
+	if len(q.Columns) == 0 && len(q.Aggregates) == 0 {
+		return q.Fields
+	}
+	fields := make([]string, 0, len(q.Columns)+len(q.Aggregates))
+	fields = append(fields, q.Columns...)
+	fields = append(fields, q.Aggregates...)
+	return fields
+}
+
+// Query events with Discover.
+// https://docs.sentry.io/api/discover/query-discover-events-in-table-format/
+func (s *DiscoverService) Query(ctx context.Context, organizationSlug string, params *DiscoverQueryParams) (*DiscoverQueryResult, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/events/", organizationSlug)
+	u, err := addQuery(u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(DiscoverQueryResult)
+	resp, err := s.client.Do(ctx, req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return result, resp, nil
+}
+
+// DiscoverTimeSeries represents a time series of a y-axis.
+type DiscoverTimeSeries struct {
+	Data []*DiscoverTimeSeriesPoint `json:"data"`
+	// Order is the rank of the series among top events.
+	Order         *int          `json:"order,omitempty"`
+	Start         *int64        `json:"start,omitempty"`
+	End           *int64        `json:"end,omitempty"`
+	Meta          *DiscoverMeta `json:"meta,omitempty"`
+	IsMetricsData *bool         `json:"isMetricsData,omitempty"`
+}
+
+// DiscoverTimeSeriesPoint is a point of a time series.
+type DiscoverTimeSeriesPoint struct {
+	Timestamp time.Time
+	Count     float64
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// Points are encoded as [timestamp, [{"count": value}]].
+func (p *DiscoverTimeSeriesPoint) UnmarshalJSON(data []byte) error {
+	var raw [2]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	var timestamp int64
+	if err := json.Unmarshal(raw[0], &timestamp); err != nil {
+		return err
+	}
+
+	var counts []struct {
+		Count *float64 `json:"count"`
+	}
+	if err := json.Unmarshal(raw[1], &counts); err != nil {
+		return err
+	}
+
+	p.Timestamp = time.Unix(timestamp, 0).UTC()
+	p.Count = 0
+	for _, c := range counts {
+		if c.Count != nil {
+			p.Count += *c.Count
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p DiscoverTimeSeriesPoint) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]interface{}{
+		p.Timestamp.Unix(),
+		[]map[string]float64{{"count": p.Count}},
+	})
+}
+
+// DiscoverEventsStats represents the result of a Discover time series query.
+// Which field is set depends on the query:
+//   - Series for a single y-axis,
+//   - Multi, keyed by y-axis for several y-axes, or by group for top events
+//     with a single y-axis,
+//   - Grouped, keyed by group then y-axis, for top events with several y-axes.
+type DiscoverEventsStats struct {
+	Series  *DiscoverTimeSeries
+	Multi   map[string]*DiscoverTimeSeries
+	Grouped map[string]map[string]*DiscoverTimeSeries
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *DiscoverEventsStats) UnmarshalJSON(data []byte) error {
+	*s = DiscoverEventsStats{}
+
+	var objects map[string]json.RawMessage
+	if err := json.Unmarshal(data, &objects); err != nil {
+		return err
+	}
+	if _, ok := objects["data"]; ok {
+		return json.Unmarshal(data, &s.Series)
+	}
+
+	// Series are keyed by y-axis or group, or nested by group and y-axis.
+	for key, value := range objects {
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(value, &fields); err != nil {
+			return err
+		}
+		if _, ok := fields["data"]; ok {
+			if s.Multi == nil {
+				s.Multi = map[string]*DiscoverTimeSeries{}
+			}
+			series := new(DiscoverTimeSeries)
+			if err := json.Unmarshal(value, series); err != nil {
+				return err
+			}
+			s.Multi[key] = series
+			continue
+		}
+
+		if s.Grouped == nil {
+			s.Grouped = map[string]map[string]*DiscoverTimeSeries{}
+		}
+		group := map[string]*DiscoverTimeSeries{}
+		for yAxis, v := range fields {
+			// Top events responses carry the group order next to the y-axes.
+			if yAxis == "order" {
+				continue
+			}
+			series := new(DiscoverTimeSeries)
+			if err := json.Unmarshal(v, series); err != nil {
+				return err
+			}
+			group[yAxis] = series
+		}
+		s.Grouped[key] = group
+	}
+	return nil
+}
+
+// DiscoverEventsStatsParams are the parameters for DiscoverService.EventsStats.
+type DiscoverEventsStatsParams struct {
+	// YAxis lists the aggregates to plot, such as "count()".
+	YAxis []string `url:"yAxis,omitempty"`
+	// Field lists the columns grouping top events, with the aggregates
+	// to rank them by.
+	Field []string `url:"field,omitempty"`
+	// Interval is the resolution of the series, such as "1h".
+	Interval  *string `url:"interval,omitempty"`
+	TopEvents *int    `url:"topEvents,omitempty"`
+	// Sort is the field ranking top events, prefixed with "-" for
+	// descending order.
+	Sort        *string    `url:"orderby,omitempty"`
+	Query       *string    `url:"query,omitempty"`
+	Dataset     *string    `url:"dataset,omitempty"`
+	Project     []int      `url:"project,omitempty"`
+	Environment []string   `url:"environment,omitempty"`
+	StatsPeriod *string    `url:"statsPeriod,omitempty"`
+	Start       *time.Time `url:"start,omitempty"`
+	End         *time.Time `url:"end,omitempty"`
+}
+
+// NewDiscoverEventsStatsParams returns the Discover time series parameters
+// matching a dashboard widget query, plotting its aggregates.
+func NewDiscoverEventsStatsParams(q *DashboardWidgetQuery) *DiscoverEventsStatsParams {
+	params := &DiscoverEventsStatsParams{
+		YAxis: q.Aggregates,
+		Query: q.Conditions,
+	}
+	if len(q.Columns) > 0 {
+		params.Field = widgetQueryFields(q)
+	}
+	if q.OrderBy != nil && *q.OrderBy != "" {
+		params.Sort = q.OrderBy
+	}
+	return params
+}
+
+// EventsStats queries the time series of events with Discover.
+func (s *DiscoverService) EventsStats(ctx context.Context, organizationSlug string, params *DiscoverEventsStatsParams) (*DiscoverEventsStats, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/events-stats/", organizationSlug)
+	u, err := addQuery(u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stats := new(DiscoverEventsStats)
+	resp, err := s.client.Do(ctx, req, stats)
+	if err != nil {
+		return nil, resp, err
+	}
+	return stats, resp, nil
+}
@@ -0,0 +1,251 @@
+package sentry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiscoverService_Query(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/events/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assert.Equal(t, url.Values{
+			"field":       {"transaction", "p95(transaction.duration)", "count()"},
+			"query":       {"event.type:transaction"},
+			"sort":        {"-count()"},
+			"per_page":    {"2"},
+			"dataset":     {"transactions"},
+			"project":     {"1", "2"},
+			"environment": {"production"},
+			"statsPeriod": {"7d"},
+		}, r.URL.Query())
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"data": [
+				{"transaction": "/checkout", "p95(transaction.duration)": 812.5, "count()": 120},
+				{"transaction": "/cart", "p95(transaction.duration)": 230, "count()": 80}
+			],
+			"meta": {
+				"fields": {
+					"transaction": "string",
+					"p95(transaction.duration)": "duration",
+					"count()": "integer"
+				},
+				"units": {
+					"transaction": null,
+					"p95(transaction.duration)": "millisecond",
+					"count()": null
+				},
+				"isMetricsData": false
+			}
+		}`)
+	})
+
+	ctx := context.Background()
+	result, _, err := client.Discover.Query(ctx, "the-interstellar-jurisdiction", &DiscoverQueryParams{
+		Field:       []string{"transaction", "p95(transaction.duration)", "count()"},
+		Query:       String("event.type:transaction"),
+		Sort:        []string{"-count()"},
+		PerPage:     Int(2),
+		Dataset:     String(DiscoverDatasetTransactions),
+		Project:     []int{1, 2},
+		Environment: []string{"production"},
+		StatsPeriod: String("7d"),
+	})
+	assert.NoError(t, err)
+
+	expected := &DiscoverQueryResult{
+		Data: []map[string]interface{}{
+			{"transaction": "/checkout", "p95(transaction.duration)": json.Number("812.5"), "count()": json.Number("120")},
+			{"transaction": "/cart", "p95(transaction.duration)": json.Number("230"), "count()": json.Number("80")},
+		},
+		Meta: &DiscoverMeta{
+			Fields: map[string]string{
+				"transaction":               "string",
+				"p95(transaction.duration)": "duration",
+				"count()":                   "integer",
+			},
+			Units: map[string]*string{
+				"transaction":               nil,
+				"p95(transaction.duration)": String("millisecond"),
+				"count()":                   nil,
+			},
+			IsMetricsData: Bool(false),
+		},
+	}
+	assert.Equal(t, expected, result)
+}
+
+func TestNewDiscoverQueryParams(t *testing.T) {
+	params := NewDiscoverQueryParams(&DashboardWidgetQuery{
+		Fields:     []string{"transaction", "count()"},
+		Aggregates: []string{"count()"},
+		Columns:    []string{"transaction"},
+		Conditions: String("event.type:transaction"),
+		OrderBy:    String("-count()"),
+	})
+	assert.Equal(t, &DiscoverQueryParams{
+		Field: []string{"transaction", "count()"},
+		Query: String("event.type:transaction"),
+		Sort:  []string{"-count()"},
+	}, params)
+
+	params = NewDiscoverQueryParams(&DashboardWidgetQuery{
+		Fields:  []string{"count()"},
+		OrderBy: String(""),
+	})
+	assert.Equal(t, &DiscoverQueryParams{Field: []string{"count()"}}, params)
+}
+
+func TestDiscoverService_EventsStats(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/events-stats/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assert.Equal(t, url.Values{
+			"yAxis":       {"count()"},
+			"interval":    {"1h"},
+			"query":       {"event.type:error"},
+			"environment": {"production"},
+			"start":       {"2024-01-01T00:00:00Z"},
+			"end":         {"2024-01-01T02:00:00Z"},
+		}, r.URL.Query())
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"data": [
+				[1704067200, [{"count": 3}]],
+				[1704070800, [{"count": 0}]]
+			],
+			"start": 1704067200,
+			"end": 1704074400,
+			"meta": {"fields": {"count()": "integer"}, "units": {"count()": null}},
+			"isMetricsData": false
+		}`)
+	})
+
+	ctx := context.Background()
+	stats, _, err := client.Discover.EventsStats(ctx, "the-interstellar-jurisdiction", &DiscoverEventsStatsParams{
+		YAxis:       []string{"count()"},
+		Interval:    String("1h"),
+		Query:       String("event.type:error"),
+		Environment: []string{"production"},
+		Start:       Time(mustParseTime("2024-01-01T00:00:00Z")),
+		End:         Time(mustParseTime("2024-01-01T02:00:00Z")),
+	})
+	assert.NoError(t, err)
+
+	start := int64(1704067200)
+	end := int64(1704074400)
+	expected := &DiscoverEventsStats{
+		Series: &DiscoverTimeSeries{
+			Data: []*DiscoverTimeSeriesPoint{
+				{Timestamp: time.Unix(1704067200, 0).UTC(), Count: 3},
+				{Timestamp: time.Unix(1704070800, 0).UTC(), Count: 0},
+			},
+			Start: &start,
+			End:   &end,
+			Meta: &DiscoverMeta{
+				Fields: map[string]string{"count()": "integer"},
+				Units:  map[string]*string{"count()": nil},
+			},
+			IsMetricsData: Bool(false),
+		},
+	}
+	assert.Equal(t, expected, stats)
+}
+
+func TestDiscoverService_EventsStats_topEvents(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/events-stats/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assert.Equal(t, url.Values{
+			"yAxis":     {"count()"},
+			"field":     {"transaction", "count()"},
+			"topEvents": {"2"},
+			"orderby":   {"-count()"},
+		}, r.URL.Query())
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"/checkout": {"data": [[1704067200, [{"count": 5}]]], "order": 0},
+			"/cart": {"data": [[1704067200, [{"count": 2}]]], "order": 1}
+		}`)
+	})
+
+	params := NewDiscoverEventsStatsParams(&DashboardWidgetQuery{
+		Aggregates: []string{"count()"},
+		Columns:    []string{"transaction"},
+		OrderBy:    String("-count()"),
+	})
+	params.TopEvents = Int(2)
+
+	ctx := context.Background()
+	stats, _, err := client.Discover.EventsStats(ctx, "the-interstellar-jurisdiction", params)
+	assert.NoError(t, err)
+
+	timestamp := time.Unix(1704067200, 0).UTC()
+	expected := &DiscoverEventsStats{
+		Multi: map[string]*DiscoverTimeSeries{
+			"/checkout": {
+				Data:  []*DiscoverTimeSeriesPoint{{Timestamp: timestamp, Count: 5}},
+				Order: Int(0),
+			},
+			"/cart": {
+				Data:  []*DiscoverTimeSeriesPoint{{Timestamp: timestamp, Count: 2}},
+				Order: Int(1),
+			},
+		},
+	}
+	assert.Equal(t, expected, stats)
+}
+
+func TestDiscoverService_EventsStats_topEventsMultipleYAxes(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/events-stats/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"/checkout": {
+				"count()": {"data": [[1704067200, [{"count": 5}]]]},
+				"p95(transaction.duration)": {"data": [[1704067200, [{"count": 812.5}]]]},
+				"order": 0
+			}
+		}`)
+	})
+
+	ctx := context.Background()
+	stats, _, err := client.Discover.EventsStats(ctx, "the-interstellar-jurisdiction", &DiscoverEventsStatsParams{
+		YAxis:     []string{"count()", "p95(transaction.duration)"},
+		Field:     []string{"transaction", "count()"},
+		TopEvents: Int(1),
+	})
+	assert.NoError(t, err)
+
+	timestamp := time.Unix(1704067200, 0).UTC()
+	expected := &DiscoverEventsStats{
+		Grouped: map[string]map[string]*DiscoverTimeSeries{
+			"/checkout": {
+				"count()": {
+					Data: []*DiscoverTimeSeriesPoint{{Timestamp: timestamp, Count: 5}},
+				},
+				"p95(transaction.duration)": {
+					Data: []*DiscoverTimeSeriesPoint{{Timestamp: timestamp, Count: 812.5}},
+				},
+			},
+		},
+	}
+	assert.Equal(t, expected, stats)
+}
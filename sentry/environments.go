@@ -0,0 +1,116 @@
+package sentry
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// Environment visibilities.
+const (
+	EnvironmentVisibilityAll     = "all"
+	EnvironmentVisibilityVisible = "visible"
+	EnvironmentVisibilityHidden  = "hidden"
+)
+
+// Environment represents a Sentry environment. IsHidden is only set for
+// project environments.
+// https://github.com/getsentry/sentry/blob/24.10.0/src/sentry/api/serializers/models/environment.py
+type Environment struct {
+	ID       *string `json:"id,omitempty"`
+	Name     *string `json:"name,omitempty"`
+	IsHidden *bool   `json:"isHidden,omitempty"`
+}
+
+// EnvironmentsService provides methods for accessing Sentry environment API endpoints.
+// https://docs.sentry.io/api/environments/
+type EnvironmentsService service
+
+// ListEnvironmentsParams are the parameters for EnvironmentsService.List and
+// EnvironmentsService.ListByProject.
+type ListEnvironmentsParams struct {
+	// Visibility is one of EnvironmentVisibilityVisible (the default),
+	// EnvironmentVisibilityHidden or EnvironmentVisibilityAll.
+	Visibility *string `url:"visibility,omitempty"`
+}
+
+// List the environments of an organization.
+func (s *EnvironmentsService) List(ctx context.Context, organizationSlug string, params *ListEnvironmentsParams) ([]*Environment, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/environments/", organizationSlug)
+	return s.list(ctx, u, params)
+}
+
+// ListByProject lists the environments of a project.
+// https://docs.sentry.io/api/environments/list-a-projects-environments/
+func (s *EnvironmentsService) ListByProject(ctx context.Context, organizationSlug string, projectSlug string, params *ListEnvironmentsParams) ([]*Environment, *Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/environments/", organizationSlug, projectSlug)
+	return s.list(ctx, u, params)
+}
+
+func (s *EnvironmentsService) list(ctx context.Context, u string, params *ListEnvironmentsParams) ([]*Environment, *Response, error) {
+	u, err := addQuery(u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	environments := []*Environment{}
+	resp, err := s.client.Do(ctx, req, &environments)
+	if err != nil {
+		return nil, resp, err
+	}
+	return environments, resp, nil
+}
+
+// GetByProject returns an environment of a project.
+// https://docs.sentry.io/api/environments/retrieve-a-project-environment/
+func (s *EnvironmentsService) GetByProject(ctx context.Context, organizationSlug string, projectSlug string, name string) (*Environment, *Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/environments/%v/", organizationSlug, projectSlug, url.PathEscape(name))
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	environment := new(Environment)
+	resp, err := s.client.Do(ctx, req, environment)
+	if err != nil {
+		return nil, resp, err
+	}
+	return environment, resp, nil
+}
+
+// UpdateProjectEnvironmentParams are the parameters for EnvironmentsService.UpdateByProject.
+type UpdateProjectEnvironmentParams struct {
+	IsHidden *bool `json:"isHidden,omitempty"`
+}
+
+// UpdateByProject updates an environment of a project.
+// https://docs.sentry.io/api/environments/update-a-project-environment/
+func (s *EnvironmentsService) UpdateByProject(ctx context.Context, organizationSlug string, projectSlug string, name string, params *UpdateProjectEnvironmentParams) (*Environment, *Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/environments/%v/", organizationSlug, projectSlug, url.PathEscape(name))
+	req, err := s.client.NewRequest("PUT", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	environment := new(Environment)
+	resp, err := s.client.Do(ctx, req, environment)
+	if err != nil {
+		return nil, resp, err
+	}
+	return environment, resp, nil
+}
+
+// Hide an environment of a project.
+func (s *EnvironmentsService) Hide(ctx context.Context, organizationSlug string, projectSlug string, name string) (*Environment, *Response, error) {
+	return s.UpdateByProject(ctx, organizationSlug, projectSlug, name, &UpdateProjectEnvironmentParams{IsHidden: Bool(true)})
+}
+
+// Unhide an environment of a project.
+func (s *EnvironmentsService) Unhide(ctx context.Context, organizationSlug string, projectSlug string, name string) (*Environment, *Response, error) {
+	return s.UpdateByProject(ctx, organizationSlug, projectSlug, name, &UpdateProjectEnvironmentParams{IsHidden: Bool(false)})
+}
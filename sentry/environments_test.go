@@ -0,0 +1,100 @@
+package sentry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnvironmentsService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/environments/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"visibility": "all"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[
+			{"id": "1", "name": "production"},
+			{"id": "2", "name": "staging"}
+		]`)
+	})
+
+	ctx := context.Background()
+	environments, _, err := client.Environments.List(ctx, "the-interstellar-jurisdiction", &ListEnvironmentsParams{
+		Visibility: String(EnvironmentVisibilityAll),
+	})
+	assert.NoError(t, err)
+
+	expected := []*Environment{
+		{ID: String("1"), Name: String("production")},
+		{ID: String("2"), Name: String("staging")},
+	}
+	assert.Equal(t, expected, environments)
+}
+
+func TestEnvironmentsService_ListByProject(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/environments/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"visibility": "hidden"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"id": "2", "name": "staging", "isHidden": true}]`)
+	})
+
+	ctx := context.Background()
+	environments, _, err := client.Environments.ListByProject(ctx, "the-interstellar-jurisdiction", "pump-station", &ListEnvironmentsParams{
+		Visibility: String(EnvironmentVisibilityHidden),
+	})
+	assert.NoError(t, err)
+
+	expected := []*Environment{
+		{ID: String("2"), Name: String("staging"), IsHidden: Bool(true)},
+	}
+	assert.Equal(t, expected, environments)
+}
+
+func TestEnvironmentsService_GetByProject(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/environments/production/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id": "1", "name": "production", "isHidden": false}`)
+	})
+
+	ctx := context.Background()
+	environment, _, err := client.Environments.GetByProject(ctx, "the-interstellar-jurisdiction", "pump-station", "production")
+	assert.NoError(t, err)
+	assert.Equal(t, &Environment{ID: String("1"), Name: String("production"), IsHidden: Bool(false)}, environment)
+}
+
+func TestEnvironmentsService_HideUnhide(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var expectedHidden bool
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/environments/staging/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		assertPostJSON(t, map[string]interface{}{"isHidden": expectedHidden}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"id": "2", "name": "staging", "isHidden": %t}`, expectedHidden)
+	})
+
+	ctx := context.Background()
+	expectedHidden = true
+	environment, _, err := client.Environments.Hide(ctx, "the-interstellar-jurisdiction", "pump-station", "staging")
+	assert.NoError(t, err)
+	assert.Equal(t, Bool(true), environment.IsHidden)
+
+	expectedHidden = false
+	environment, _, err = client.Environments.Unhide(ctx, "the-interstellar-jurisdiction", "pump-station", "staging")
+	assert.NoError(t, err)
+	assert.Equal(t, Bool(false), environment.IsHidden)
+}
@@ -0,0 +1,374 @@
+package sentry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const (
+	EventEntryTypeException   string = "exception"
+	EventEntryTypeBreadcrumbs string = "breadcrumbs"
+	EventEntryTypeMessage     string = "message"
+	EventEntryTypeRequest     string = "request"
+	EventEntryTypeStacktrace  string = "stacktrace"
+	EventEntryTypeThreads     string = "threads"
+)
+
+// Event represents a Sentry event.
+// https://github.com/getsentry/sentry/blob/24.10.0/src/sentry/api/serializers/models/event.py
+type Event struct {
+	ID           *string                           `json:"id,omitempty"`
+	EventID      *string                           `json:"eventID,omitempty"`
+	GroupID      *string                           `json:"groupID,omitempty"`
+	ProjectID    *string                           `json:"projectID,omitempty"`
+	Title        *string                           `json:"title,omitempty"`
+	Message      *string                           `json:"message,omitempty"`
+	Culprit      *string                           `json:"culprit,omitempty"`
+	Location     *string                           `json:"location,omitempty"`
+	Platform     *string                           `json:"platform,omitempty"`
+	Type         *string                           `json:"type,omitempty"`
+	EventType    *string                           `json:"event.type,omitempty"`
+	DateCreated  *time.Time                        `json:"dateCreated,omitempty"`
+	DateReceived *time.Time                        `json:"dateReceived,omitempty"`
+	Size         *int                              `json:"size,omitempty"`
+	Tags         []*EventTag                       `json:"tags,omitempty"`
+	User         *EventUser                        `json:"user,omitempty"`
+	Contexts     map[string]map[string]interface{} `json:"contexts,omitempty"`
+	Entries      []*EventEntry                     `json:"entries,omitempty"`
+	SDK          *EventSDK                         `json:"sdk,omitempty"`
+	Fingerprints []string                          `json:"fingerprints,omitempty"`
+	Metadata     map[string]interface{}            `json:"metadata,omitempty"`
+	Packages     map[string]string                 `json:"packages,omitempty"`
+	Errors       []map[string]interface{}          `json:"errors,omitempty"`
+	CrashFile    json.RawMessage                   `json:"crashFile,omitempty"`
+	// TODO: release
+	// TODO: occurrence
+}
+
+// EventTag represents a tag of an event.
+type EventTag struct {
+	Key   *string `json:"key,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
+
+// EventUser represents the user affected by an event.
+type EventUser struct {
+	ID        *string                `json:"id,omitempty"`
+	Email     *string                `json:"email,omitempty"`
+	Username  *string                `json:"username,omitempty"`
+	IPAddress *string                `json:"ip_address,omitempty"`
+	Name      *string                `json:"name,omitempty"`
+	Geo       map[string]string      `json:"geo,omitempty"`
+	Data      map[string]interface{} `json:"data,omitempty"`
+}
+
+// EventSDK represents the SDK that sent an event.
+type EventSDK struct {
+	Name    *string `json:"name,omitempty"`
+	Version *string `json:"version,omitempty"`
+}
+
+// EventEntry represents an interface entry of an event, such as its
+// exception or breadcrumbs. Data always holds the raw entry data, and the
+// typed field matching Type is populated for known entry types.
+type EventEntry struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+
+	Exception   *EventException   `json:"-"`
+	Breadcrumbs *EventBreadcrumbs `json:"-"`
+	Message     *EventMessage     `json:"-"`
+	Request     *EventRequest     `json:"-"`
+	Stacktrace  *EventStacktrace  `json:"-"`
+	Threads     *EventThreads     `json:"-"`
+}
+
+var _ json.Unmarshaler = (*EventEntry)(nil)
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *EventEntry) UnmarshalJSON(data []byte) error {
+	type entry EventEntry
+	var raw entry
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*e = EventEntry(raw)
+
+	if len(e.Data) == 0 || string(e.Data) == "null" {
+		return nil
+	}
+
+	var v interface{}
+	switch e.Type {
+	case EventEntryTypeException:
+		e.Exception = new(EventException)
+		v = e.Exception
+	case EventEntryTypeBreadcrumbs:
+		e.Breadcrumbs = new(EventBreadcrumbs)
+		v = e.Breadcrumbs
+	case EventEntryTypeMessage:
+		e.Message = new(EventMessage)
+		v = e.Message
+	case EventEntryTypeRequest:
+		e.Request = new(EventRequest)
+		v = e.Request
+	case EventEntryTypeStacktrace:
+		e.Stacktrace = new(EventStacktrace)
+		v = e.Stacktrace
+	case EventEntryTypeThreads:
+		e.Threads = new(EventThreads)
+		v = e.Threads
+	default:
+		return nil
+	}
+	return json.Unmarshal(e.Data, v)
+}
+
+// EventException represents the data of an exception entry.
+type EventException struct {
+	Values          []*EventExceptionValue `json:"values,omitempty"`
+	ExcOmitted      []int                  `json:"excOmitted,omitempty"`
+	HasSystemFrames *bool                  `json:"hasSystemFrames,omitempty"`
+}
+
+// EventExceptionValue represents a single exception in an exception chain.
+type EventExceptionValue struct {
+	Type          *string                  `json:"type,omitempty"`
+	Value         *string                  `json:"value,omitempty"`
+	Module        *string                  `json:"module,omitempty"`
+	ThreadID      *Int64OrString           `json:"threadId,omitempty"`
+	Mechanism     *EventExceptionMechanism `json:"mechanism,omitempty"`
+	Stacktrace    *EventStacktrace         `json:"stacktrace,omitempty"`
+	RawStacktrace *EventStacktrace         `json:"rawStacktrace,omitempty"`
+}
+
+// EventExceptionMechanism describes how an exception was captured.
+type EventExceptionMechanism struct {
+	Type        *string                `json:"type,omitempty"`
+	Handled     *bool                  `json:"handled,omitempty"`
+	Synthetic   *bool                  `json:"synthetic,omitempty"`
+	Description *string                `json:"description,omitempty"`
+	HelpLink    *string                `json:"help_link,omitempty"`
+	Data        map[string]interface{} `json:"data,omitempty"`
+	Meta        map[string]interface{} `json:"meta,omitempty"`
+}
+
+// EventStacktrace represents a stack trace.
+type EventStacktrace struct {
+	Frames          []*EventFrame     `json:"frames,omitempty"`
+	FramesOmitted   []int             `json:"framesOmitted,omitempty"`
+	Registers       map[string]string `json:"registers,omitempty"`
+	HasSystemFrames *bool             `json:"hasSystemFrames,omitempty"`
+}
+
+// EventFrame represents a single frame of a stack trace.
+type EventFrame struct {
+	Filename        *string                `json:"filename,omitempty"`
+	AbsPath         *string                `json:"absPath,omitempty"`
+	Module          *string                `json:"module,omitempty"`
+	Package         *string                `json:"package,omitempty"`
+	Platform        *string                `json:"platform,omitempty"`
+	InstructionAddr *string                `json:"instructionAddr,omitempty"`
+	SymbolAddr      *string                `json:"symbolAddr,omitempty"`
+	Function        *string                `json:"function,omitempty"`
+	RawFunction     *string                `json:"rawFunction,omitempty"`
+	Symbol          *string                `json:"symbol,omitempty"`
+	Context         [][]interface{}        `json:"context,omitempty"` // Pairs of line number and source line.
+	LineNo          *int                   `json:"lineNo,omitempty"`
+	ColNo           *int                   `json:"colNo,omitempty"`
+	InApp           *bool                  `json:"inApp,omitempty"`
+	Trust           *string                `json:"trust,omitempty"`
+	Vars            map[string]interface{} `json:"vars,omitempty"`
+	SourceLink      *string                `json:"sourceLink,omitempty"`
+}
+
+// EventBreadcrumbs represents the data of a breadcrumbs entry.
+type EventBreadcrumbs struct {
+	Values []*EventBreadcrumb `json:"values,omitempty"`
+}
+
+// EventBreadcrumb represents a single breadcrumb.
+type EventBreadcrumb struct {
+	Timestamp *time.Time             `json:"timestamp,omitempty"`
+	Type      *string                `json:"type,omitempty"`
+	Category  *string                `json:"category,omitempty"`
+	Level     *string                `json:"level,omitempty"`
+	Message   *string                `json:"message,omitempty"`
+	EventID   *string                `json:"event_id,omitempty"`
+	Data      map[string]interface{} `json:"data,omitempty"`
+}
+
+// EventMessage represents the data of a message entry.
+type EventMessage struct {
+	Formatted *string `json:"formatted,omitempty"`
+	Message   *string `json:"message,omitempty"`
+}
+
+// EventRequest represents the data of a request entry.
+type EventRequest struct {
+	Method              *string         `json:"method,omitempty"`
+	URL                 *string         `json:"url,omitempty"`
+	Query               json.RawMessage `json:"query,omitempty"`
+	Fragment            *string         `json:"fragment,omitempty"`
+	Data                json.RawMessage `json:"data,omitempty"`
+	Headers             [][]string      `json:"headers,omitempty"`
+	Cookies             json.RawMessage `json:"cookies,omitempty"`
+	Env                 json.RawMessage `json:"env,omitempty"`
+	InferredContentType *string         `json:"inferredContentType,omitempty"`
+}
+
+// EventThreads represents the data of a threads entry.
+type EventThreads struct {
+	Values []*EventThread `json:"values,omitempty"`
+}
+
+// EventThread represents a single thread.
+type EventThread struct {
+	ID            *Int64OrString   `json:"id,omitempty"`
+	Name          *string          `json:"name,omitempty"`
+	Current       *bool            `json:"current,omitempty"`
+	Crashed       *bool            `json:"crashed,omitempty"`
+	Stacktrace    *EventStacktrace `json:"stacktrace,omitempty"`
+	RawStacktrace *EventStacktrace `json:"rawStacktrace,omitempty"`
+}
+
+// EventIDLookup represents the result of resolving an event ID.
+type EventIDLookup struct {
+	OrganizationSlug *string `json:"organizationSlug,omitempty"`
+	ProjectSlug      *string `json:"projectSlug,omitempty"`
+	GroupID          *string `json:"groupId,omitempty"`
+	EventID          *string `json:"eventId,omitempty"`
+	Event            *Event  `json:"event,omitempty"`
+}
+
+// EventsService provides methods for accessing Sentry event API endpoints.
+// https://docs.sentry.io/api/events/
+type EventsService service
+
+// ListIssueEventsParams are the parameters for EventsService.ListByIssue.
+type ListIssueEventsParams struct {
+	ListCursorParams
+
+	// Whether to return the full event body, including the stack trace.
+	Full        *bool      `url:"full,omitempty"`
+	Query       *string    `url:"query,omitempty"`
+	Environment []string   `url:"environment,omitempty"`
+	StatsPeriod *string    `url:"statsPeriod,omitempty"`
+	Start       *time.Time `url:"start,omitempty"`
+	End         *time.Time `url:"end,omitempty"`
+}
+
+// ListByIssue lists the events of an issue.
+// https://docs.sentry.io/api/events/list-an-issues-events/
+func (s *EventsService) ListByIssue(ctx context.Context, organizationSlug string, issueID string, params *ListIssueEventsParams) ([]*Event, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/issues/%v/events/", organizationSlug, issueID)
+	u, err := addQuery(u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	events := []*Event{}
+	resp, err := s.client.Do(ctx, req, &events)
+	if err != nil {
+		return nil, resp, err
+	}
+	return events, resp, nil
+}
+
+// ListProjectEventsParams are the parameters for EventsService.ListByProject.
+type ListProjectEventsParams struct {
+	ListCursorParams
+
+	// Whether to return the full event body, including the stack trace.
+	Full *bool `url:"full,omitempty"`
+}
+
+// ListByProject lists the events of a project.
+// https://docs.sentry.io/api/events/list-a-projects-error-events/
+func (s *EventsService) ListByProject(ctx context.Context, organizationSlug string, projectSlug string, params *ListProjectEventsParams) ([]*Event, *Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/events/", organizationSlug, projectSlug)
+	u, err := addQuery(u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	events := []*Event{}
+	resp, err := s.client.Do(ctx, req, &events)
+	if err != nil {
+		return nil, resp, err
+	}
+	return events, resp, nil
+}
+
+// Get an event of a project.
+// https://docs.sentry.io/api/events/retrieve-an-event-for-a-project/
+func (s *EventsService) Get(ctx context.Context, organizationSlug string, projectSlug string, eventID string) (*Event, *Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/events/%v/", organizationSlug, projectSlug, eventID)
+	return s.get(ctx, u)
+}
+
+// GetByIssue returns an event of an issue. Besides an event ID, eventID may
+// be "latest", "oldest" or "recommended".
+// https://docs.sentry.io/api/events/retrieve-an-issue-event/
+func (s *EventsService) GetByIssue(ctx context.Context, organizationSlug string, issueID string, eventID string) (*Event, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/issues/%v/events/%v/", organizationSlug, issueID, eventID)
+	return s.get(ctx, u)
+}
+
+// GetLatest returns the latest event of an issue.
+func (s *EventsService) GetLatest(ctx context.Context, organizationSlug string, issueID string) (*Event, *Response, error) {
+	return s.GetByIssue(ctx, organizationSlug, issueID, "latest")
+}
+
+// GetOldest returns the oldest event of an issue.
+func (s *EventsService) GetOldest(ctx context.Context, organizationSlug string, issueID string) (*Event, *Response, error) {
+	return s.GetByIssue(ctx, organizationSlug, issueID, "oldest")
+}
+
+// GetRecommended returns the event Sentry recommends for debugging an issue.
+func (s *EventsService) GetRecommended(ctx context.Context, organizationSlug string, issueID string) (*Event, *Response, error) {
+	return s.GetByIssue(ctx, organizationSlug, issueID, "recommended")
+}
+
+func (s *EventsService) get(ctx context.Context, u string) (*Event, *Response, error) {
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	event := new(Event)
+	resp, err := s.client.Do(ctx, req, event)
+	if err != nil {
+		return nil, resp, err
+	}
+	return event, resp, nil
+}
+
+// ResolveEventID looks up an event ID across the projects of an organization.
+// https://docs.sentry.io/api/organizations/resolve-an-event-id/
+func (s *EventsService) ResolveEventID(ctx context.Context, organizationSlug string, eventID string) (*EventIDLookup, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/eventids/%v/", organizationSlug, eventID)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	lookup := new(EventIDLookup)
+	resp, err := s.client.Do(ctx, req, lookup)
+	if err != nil {
+		return nil, resp, err
+	}
+	return lookup, resp, nil
+}
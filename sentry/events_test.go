@@ -0,0 +1,340 @@
+package sentry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventsService_ListByIssue(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/issues/1/events/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"full": "true", "environment": "production"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[
+			{
+				"eventID": "9fac2ceed9344f2bbfdd1fdacb0ed9b1",
+				"tags": [
+					{"key": "browser", "value": "Chrome 60.0"},
+					{"key": "level", "value": "error"}
+				],
+				"dateCreated": "2020-09-11T17:46:36Z",
+				"user": null,
+				"message": "",
+				"title": "This is an example Python exception",
+				"id": "dfb1a2d057194e76a4186cc8a5271553",
+				"platform": "python",
+				"event.type": "error",
+				"groupID": "1"
+			}
+		]`)
+	})
+
+	ctx := context.Background()
+	events, _, err := client.Events.ListByIssue(ctx, "the-interstellar-jurisdiction", "1", &ListIssueEventsParams{
+		Full:        Bool(true),
+		Environment: []string{"production"},
+	})
+	assert.NoError(t, err)
+
+	expected := []*Event{
+		{
+			EventID: String("9fac2ceed9344f2bbfdd1fdacb0ed9b1"),
+			Tags: []*EventTag{
+				{Key: String("browser"), Value: String("Chrome 60.0")},
+				{Key: String("level"), Value: String("error")},
+			},
+			DateCreated: Time(mustParseTime("2020-09-11T17:46:36Z")),
+			Message:     String(""),
+			Title:       String("This is an example Python exception"),
+			ID:          String("dfb1a2d057194e76a4186cc8a5271553"),
+			Platform:    String("python"),
+			EventType:   String("error"),
+			GroupID:     String("1"),
+		},
+	}
+	assert.Equal(t, expected, events)
+}
+
+func TestEventsService_ListByProject(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/events/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"cursor": "100:1:0"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"id": "dfb1a2d057194e76a4186cc8a5271553", "projectID": "2"}]`)
+	})
+
+	ctx := context.Background()
+	events, _, err := client.Events.ListByProject(ctx, "the-interstellar-jurisdiction", "pump-station", &ListProjectEventsParams{
+		ListCursorParams: ListCursorParams{Cursor: "100:1:0"},
+	})
+	assert.NoError(t, err)
+
+	expected := []*Event{
+		{ID: String("dfb1a2d057194e76a4186cc8a5271553"), ProjectID: String("2")},
+	}
+	assert.Equal(t, expected, events)
+}
+
+func TestEventsService_GetLatest(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/issues/1/events/latest/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"id": "9999aaaaca8b46d797c23c6077c6ff01",
+			"eventID": "9999aaaaca8b46d797c23c6077c6ff01",
+			"groupID": "1",
+			"title": "ZeroDivisionError: division by zero",
+			"dateCreated": "2020-09-11T17:46:36Z",
+			"dateReceived": "2020-09-11T17:46:38.137Z",
+			"user": {
+				"id": "42",
+				"email": "jane@example.com",
+				"ip_address": "127.0.0.1"
+			},
+			"contexts": {
+				"os": {"type": "os", "name": "Linux"},
+				"runtime": {"type": "runtime", "name": "CPython", "version": "3.8.2"}
+			},
+			"sdk": {"name": "sentry.python", "version": "0.17.4"},
+			"fingerprints": ["c4c7dd4a0b4ec8d2fd1c3e0d3c9ac4a5"],
+			"entries": [
+				{
+					"type": "exception",
+					"data": {
+						"values": [
+							{
+								"type": "ZeroDivisionError",
+								"value": "division by zero",
+								"module": null,
+								"mechanism": {"type": "excepthook", "handled": false},
+								"stacktrace": {
+									"frames": [
+										{
+											"filename": "app.py",
+											"absPath": "/srv/app.py",
+											"module": "app",
+											"function": "divide",
+											"context": [[9, "def divide(a, b):"], [10, "    return a / b"]],
+											"lineNo": 10,
+											"colNo": null,
+											"inApp": true,
+											"vars": {"a": "1", "b": "0"}
+										}
+									],
+									"framesOmitted": null,
+									"hasSystemFrames": false
+								}
+							}
+						],
+						"excOmitted": null,
+						"hasSystemFrames": false
+					}
+				},
+				{
+					"type": "breadcrumbs",
+					"data": {
+						"values": [
+							{
+								"timestamp": "2020-09-11T17:46:35.000Z",
+								"type": "default",
+								"category": "query",
+								"level": "info",
+								"message": "SELECT 1",
+								"data": null,
+								"event_id": null
+							}
+						]
+					}
+				},
+				{
+					"type": "message",
+					"data": {"formatted": "division by zero"}
+				},
+				{
+					"type": "spans",
+					"data": [{"span_id": "a1b2"}]
+				}
+			]
+		}`)
+	})
+
+	ctx := context.Background()
+	event, _, err := client.Events.GetLatest(ctx, "the-interstellar-jurisdiction", "1")
+	assert.NoError(t, err)
+
+	assert.Equal(t, String("9999aaaaca8b46d797c23c6077c6ff01"), event.ID)
+	assert.Equal(t, Time(mustParseTime("2020-09-11T17:46:38.137Z")), event.DateReceived)
+	assert.Equal(t, &EventUser{
+		ID:        String("42"),
+		Email:     String("jane@example.com"),
+		IPAddress: String("127.0.0.1"),
+	}, event.User)
+	assert.Equal(t, map[string]map[string]interface{}{
+		"os":      {"type": "os", "name": "Linux"},
+		"runtime": {"type": "runtime", "name": "CPython", "version": "3.8.2"},
+	}, event.Contexts)
+	assert.Equal(t, &EventSDK{Name: String("sentry.python"), Version: String("0.17.4")}, event.SDK)
+	assert.Len(t, event.Entries, 4)
+
+	exception := event.Entries[0]
+	assert.Equal(t, EventEntryTypeException, exception.Type)
+	assert.Equal(t, &EventException{
+		Values: []*EventExceptionValue{
+			{
+				Type:      String("ZeroDivisionError"),
+				Value:     String("division by zero"),
+				Mechanism: &EventExceptionMechanism{Type: String("excepthook"), Handled: Bool(false)},
+				Stacktrace: &EventStacktrace{
+					Frames: []*EventFrame{
+						{
+							Filename: String("app.py"),
+							AbsPath:  String("/srv/app.py"),
+							Module:   String("app"),
+							Function: String("divide"),
+							Context: [][]interface{}{
+								{float64(9), "def divide(a, b):"},
+								{float64(10), "    return a / b"},
+							},
+							LineNo: Int(10),
+							InApp:  Bool(true),
+							Vars:   map[string]interface{}{"a": "1", "b": "0"},
+						},
+					},
+					HasSystemFrames: Bool(false),
+				},
+			},
+		},
+		HasSystemFrames: Bool(false),
+	}, exception.Exception)
+	assert.Nil(t, exception.Breadcrumbs)
+
+	breadcrumbs := event.Entries[1]
+	assert.Equal(t, &EventBreadcrumbs{
+		Values: []*EventBreadcrumb{
+			{
+				Timestamp: Time(mustParseTime("2020-09-11T17:46:35.000Z")),
+				Type:      String("default"),
+				Category:  String("query"),
+				Level:     String("info"),
+				Message:   String("SELECT 1"),
+			},
+		},
+	}, breadcrumbs.Breadcrumbs)
+
+	assert.Equal(t, &EventMessage{Formatted: String("division by zero")}, event.Entries[2].Message)
+
+	// Unknown entry types are kept as raw JSON.
+	spans := event.Entries[3]
+	assert.Equal(t, "spans", spans.Type)
+	assert.JSONEq(t, `[{"span_id": "a1b2"}]`, string(spans.Data))
+
+	// Entries marshal back to their original shape.
+	b, err := json.Marshal(spans)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"type": "spans", "data": [{"span_id": "a1b2"}]}`, string(b))
+}
+
+func TestEventsService_GetOldestAndRecommended(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	for _, eventID := range []string{"oldest", "recommended"} {
+		eventID := eventID
+		mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/issues/1/events/"+eventID+"/", func(w http.ResponseWriter, r *http.Request) {
+			assertMethod(t, "GET", r)
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"id": %q}`, eventID)
+		})
+	}
+
+	ctx := context.Background()
+	event, _, err := client.Events.GetOldest(ctx, "the-interstellar-jurisdiction", "1")
+	assert.NoError(t, err)
+	assert.Equal(t, &Event{ID: String("oldest")}, event)
+
+	event, _, err = client.Events.GetRecommended(ctx, "the-interstellar-jurisdiction", "1")
+	assert.NoError(t, err)
+	assert.Equal(t, &Event{ID: String("recommended")}, event)
+}
+
+func TestEventsService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/events/9fac2ceed9344f2bbfdd1fdacb0ed9b1/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"id": "9fac2ceed9344f2bbfdd1fdacb0ed9b1",
+			"entries": [
+				{
+					"type": "request",
+					"data": {
+						"method": "GET",
+						"url": "http://example.com/foo",
+						"headers": [["Accept", "*/*"]]
+					}
+				}
+			]
+		}`)
+	})
+
+	ctx := context.Background()
+	event, _, err := client.Events.Get(ctx, "the-interstellar-jurisdiction", "pump-station", "9fac2ceed9344f2bbfdd1fdacb0ed9b1")
+	assert.NoError(t, err)
+	assert.Equal(t, &EventRequest{
+		Method:  String("GET"),
+		URL:     String("http://example.com/foo"),
+		Headers: [][]string{{"Accept", "*/*"}},
+	}, event.Entries[0].Request)
+}
+
+func TestEventsService_ResolveEventID(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/eventids/9fac2ceed9344f2bbfdd1fdacb0ed9b1/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"organizationSlug": "the-interstellar-jurisdiction",
+			"projectSlug": "pump-station",
+			"groupId": "1",
+			"eventId": "9fac2ceed9344f2bbfdd1fdacb0ed9b1",
+			"event": {
+				"id": "9fac2ceed9344f2bbfdd1fdacb0ed9b1",
+				"groupID": "1"
+			}
+		}`)
+	})
+
+	ctx := context.Background()
+	lookup, _, err := client.Events.ResolveEventID(ctx, "the-interstellar-jurisdiction", "9fac2ceed9344f2bbfdd1fdacb0ed9b1")
+	assert.NoError(t, err)
+
+	expected := &EventIDLookup{
+		OrganizationSlug: String("the-interstellar-jurisdiction"),
+		ProjectSlug:      String("pump-station"),
+		GroupID:          String("1"),
+		EventID:          String("9fac2ceed9344f2bbfdd1fdacb0ed9b1"),
+		Event: &Event{
+			ID:      String("9fac2ceed9344f2bbfdd1fdacb0ed9b1"),
+			GroupID: String("1"),
+		},
+	}
+	assert.Equal(t, expected, lookup)
+}
@@ -0,0 +1,203 @@
+package sentry
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// Incident statuses.
+// https://github.com/getsentry/sentry/blob/24.10.0/src/sentry/incidents/models/incident.py
+const (
+	IncidentStatusOpen     = 1
+	IncidentStatusClosed   = 2
+	IncidentStatusWarning  = 10
+	IncidentStatusCritical = 20
+)
+
+// Incident status methods, describing what changed the status of an incident.
+const (
+	IncidentStatusMethodManual        = 1
+	IncidentStatusMethodRuleUpdated   = 2
+	IncidentStatusMethodRuleTriggered = 3
+)
+
+// Incident activity types.
+const (
+	IncidentActivityTypeDetected     = 1
+	IncidentActivityTypeStatusChange = 2
+	IncidentActivityTypeStarted      = 4
+)
+
+// IncidentsService provides methods for accessing Sentry metric alert incident API endpoints.
+type IncidentsService service
+
+// Incident represents an incident opened by a metric alert.
+// https://github.com/getsentry/sentry/blob/24.10.0/src/sentry/api/serializers/models/incident.py
+type Incident struct {
+	ID             *string      `json:"id,omitempty"`
+	Identifier     *string      `json:"identifier,omitempty"`
+	OrganizationID *string      `json:"organizationId,omitempty"`
+	Projects       []string     `json:"projects,omitempty"`
+	AlertRule      *MetricAlert `json:"alertRule,omitempty"`
+	// Activities are only set when expanded with "activities".
+	Activities   []*IncidentActivity `json:"activities,omitempty"`
+	Status       *int                `json:"status,omitempty"`
+	StatusMethod *int                `json:"statusMethod,omitempty"`
+	Type         *int                `json:"type,omitempty"`
+	Title        *string             `json:"title,omitempty"`
+	DateStarted  *time.Time          `json:"dateStarted,omitempty"`
+	DateDetected *time.Time          `json:"dateDetected,omitempty"`
+	DateCreated  *time.Time          `json:"dateCreated,omitempty"`
+	DateClosed   *time.Time          `json:"dateClosed,omitempty"`
+	// DiscoverQuery is only set when getting a single incident.
+	DiscoverQuery *string `json:"discoverQuery,omitempty"`
+}
+
+// IncidentActivity represents an entry of the activity timeline of an incident.
+// https://github.com/getsentry/sentry/blob/24.10.0/src/sentry/api/serializers/models/incidentactivity.py
+type IncidentActivity struct {
+	ID                 *string `json:"id,omitempty"`
+	IncidentIdentifier *string `json:"incidentIdentifier,omitempty"`
+	// User is nil when the activity was not caused by a user.
+	User *User `json:"user,omitempty"`
+	Type *int  `json:"type,omitempty"`
+	// Value and PreviousValue hold the new and previous statuses of status
+	// changes.
+	Value         *string    `json:"value,omitempty"`
+	PreviousValue *string    `json:"previousValue,omitempty"`
+	Comment       *string    `json:"comment,omitempty"`
+	DateCreated   *time.Time `json:"dateCreated,omitempty"`
+}
+
+// IncidentStatusTransition is a status change of an incident.
+type IncidentStatusTransition struct {
+	// From is nil if the previous status is unknown.
+	From *int
+	To   int
+	Date *time.Time
+	// User is nil when the status was changed by the alert rule.
+	User *User
+}
+
+// IncidentStatusTransitions returns the status changes of an activity
+// timeline, oldest first.
+func IncidentStatusTransitions(activities []*IncidentActivity) []*IncidentStatusTransition {
+	var changes []*IncidentActivity
+	for _, activity := range activities {
+		if activity.Type != nil && *activity.Type == IncidentActivityTypeStatusChange && activity.Value != nil {
+			changes = append(changes, activity)
+		}
+	}
+	sort.SliceStable(changes, func(i, j int) bool {
+		a, b := changes[i].DateCreated, changes[j].DateCreated
+		return a != nil && b != nil && a.Before(*b)
+	})
+
+	transitions := make([]*IncidentStatusTransition, 0, len(changes))
+	for _, activity := range changes {
+		to, err := strconv.Atoi(*activity.Value)
+		if err != nil {
+			continue
+		}
+		transition := &IncidentStatusTransition{
+			To:   to,
+			Date: activity.DateCreated,
+			User: activity.User,
+		}
+		if activity.PreviousValue != nil {
+			if from, err := strconv.Atoi(*activity.PreviousValue); err == nil {
+				transition.From = &from
+			}
+		}
+		transitions = append(transitions, transition)
+	}
+	return transitions
+}
+
+// ListIncidentsParams are the parameters for IncidentsService.List.
+type ListIncidentsParams struct {
+	ListCursorParams
+
+	// The IDs of the projects to filter by.
+	Project     []int    `url:"project,omitempty"`
+	Environment []string `url:"environment,omitempty"`
+	// Status is "open" or "closed".
+	Status *string `url:"status,omitempty"`
+	// The ID of the alert rule to filter by.
+	AlertRule *string `url:"alertRule,omitempty"`
+	Title     *string `url:"title,omitempty"`
+	// Expand may include "activities" and "original_alert_rule".
+	Expand      []string   `url:"expand,omitempty"`
+	StatsPeriod *string    `url:"statsPeriod,omitempty"`
+	Start       *time.Time `url:"start,omitempty"`
+	End         *time.Time `url:"end,omitempty"`
+	PerPage     *int       `url:"per_page,omitempty"`
+}
+
+// List the incidents of an organization, most recent first.
+func (s *IncidentsService) List(ctx context.Context, organizationSlug string, params *ListIncidentsParams) ([]*Incident, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/incidents/", organizationSlug)
+	u, err := addQuery(u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	incidents := []*Incident{}
+	resp, err := s.client.Do(ctx, req, &incidents)
+	if err != nil {
+		return nil, resp, err
+	}
+	return incidents, resp, nil
+}
+
+// ListAll returns all the incidents of an organization, following pagination.
+func (s *IncidentsService) ListAll(ctx context.Context, organizationSlug string, params *ListIncidentsParams) ([]*Incident, *Response, error) {
+	p := ListIncidentsParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewPager(func(ctx context.Context, cursor string) ([]*Incident, *Response, error) {
+		p.Cursor = cursor
+		return s.List(ctx, organizationSlug, &p)
+	}).All(ctx)
+}
+
+// Get an incident by its identifier.
+func (s *IncidentsService) Get(ctx context.Context, organizationSlug string, identifier string) (*Incident, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/incidents/%v/", organizationSlug, identifier)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	incident := new(Incident)
+	resp, err := s.client.Do(ctx, req, incident)
+	if err != nil {
+		return nil, resp, err
+	}
+	return incident, resp, nil
+}
+
+// ListActivities returns the activity timeline of an incident.
+func (s *IncidentsService) ListActivities(ctx context.Context, organizationSlug string, identifier string) ([]*IncidentActivity, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/incidents/%v/activity/", organizationSlug, identifier)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	activities := []*IncidentActivity{}
+	resp, err := s.client.Do(ctx, req, &activities)
+	if err != nil {
+		return nil, resp, err
+	}
+	return activities, resp, nil
+}
@@ -0,0 +1,172 @@
+package sentry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIncidentsService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/incidents/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{
+			"status":      "closed",
+			"alertRule":   "12345",
+			"statsPeriod": "30d",
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[
+			{
+				"id": "4567",
+				"identifier": "42",
+				"organizationId": "1",
+				"projects": ["pump-station"],
+				"alertRule": {"id": "12345", "name": "pump-station-alert"},
+				"status": 2,
+				"statusMethod": 3,
+				"type": 2,
+				"title": "pump-station-alert",
+				"dateStarted": "2024-10-17T09:00:00Z",
+				"dateDetected": "2024-10-17T09:01:00Z",
+				"dateCreated": "2024-10-17T09:01:05Z",
+				"dateClosed": "2024-10-17T10:00:00Z"
+			}
+		]`)
+	})
+
+	ctx := context.Background()
+	incidents, _, err := client.Incidents.List(ctx, "the-interstellar-jurisdiction", &ListIncidentsParams{
+		Status:      String("closed"),
+		AlertRule:   String("12345"),
+		StatsPeriod: String("30d"),
+	})
+	require.NoError(t, err)
+
+	expected := []*Incident{
+		{
+			ID:             String("4567"),
+			Identifier:     String("42"),
+			OrganizationID: String("1"),
+			Projects:       []string{"pump-station"},
+			AlertRule:      &MetricAlert{ID: String("12345"), Name: String("pump-station-alert")},
+			Status:         Int(IncidentStatusClosed),
+			StatusMethod:   Int(IncidentStatusMethodRuleTriggered),
+			Type:           Int(2),
+			Title:          String("pump-station-alert"),
+			DateStarted:    Time(mustParseTime("2024-10-17T09:00:00Z")),
+			DateDetected:   Time(mustParseTime("2024-10-17T09:01:00Z")),
+			DateCreated:    Time(mustParseTime("2024-10-17T09:01:05Z")),
+			DateClosed:     Time(mustParseTime("2024-10-17T10:00:00Z")),
+		},
+	}
+	assert.Equal(t, expected, incidents)
+}
+
+func TestIncidentsService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/incidents/42/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"id": "4567",
+			"identifier": "42",
+			"status": 20,
+			"title": "pump-station-alert",
+			"discoverQuery": "event.type:error"
+		}`)
+	})
+
+	ctx := context.Background()
+	incident, _, err := client.Incidents.Get(ctx, "the-interstellar-jurisdiction", "42")
+	require.NoError(t, err)
+
+	expected := &Incident{
+		ID:            String("4567"),
+		Identifier:    String("42"),
+		Status:        Int(IncidentStatusCritical),
+		Title:         String("pump-station-alert"),
+		DiscoverQuery: String("event.type:error"),
+	}
+	assert.Equal(t, expected, incident)
+}
+
+const incidentActivitiesJSON = `[
+	{
+		"id": "3",
+		"incidentIdentifier": "42",
+		"user": {"id": "1", "name": "Jane Schmidt"},
+		"type": 2,
+		"value": "2",
+		"previousValue": "20",
+		"comment": null,
+		"dateCreated": "2024-10-17T10:00:00Z"
+	},
+	{
+		"id": "1",
+		"incidentIdentifier": "42",
+		"user": null,
+		"type": 1,
+		"value": null,
+		"previousValue": null,
+		"comment": null,
+		"dateCreated": "2024-10-17T09:01:00Z"
+	},
+	{
+		"id": "2",
+		"incidentIdentifier": "42",
+		"user": null,
+		"type": 2,
+		"value": "20",
+		"previousValue": "1",
+		"comment": null,
+		"dateCreated": "2024-10-17T09:01:05Z"
+	}
+]`
+
+func TestIncidentsService_ListActivities(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/incidents/42/activity/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, incidentActivitiesJSON)
+	})
+
+	ctx := context.Background()
+	activities, _, err := client.Incidents.ListActivities(ctx, "the-interstellar-jurisdiction", "42")
+	require.NoError(t, err)
+	require.Len(t, activities, 3)
+
+	assert.Equal(t, &IncidentActivity{
+		ID:                 String("1"),
+		IncidentIdentifier: String("42"),
+		Type:               Int(IncidentActivityTypeDetected),
+		DateCreated:        Time(mustParseTime("2024-10-17T09:01:00Z")),
+	}, activities[1])
+
+	transitions := IncidentStatusTransitions(activities)
+	expected := []*IncidentStatusTransition{
+		{
+			From: Int(IncidentStatusOpen),
+			To:   IncidentStatusCritical,
+			Date: Time(mustParseTime("2024-10-17T09:01:05Z")),
+		},
+		{
+			From: Int(IncidentStatusCritical),
+			To:   IncidentStatusClosed,
+			Date: Time(mustParseTime("2024-10-17T10:00:00Z")),
+			User: &User{ID: "1", Name: "Jane Schmidt"},
+		},
+	}
+	assert.Equal(t, expected, transitions)
+}
@@ -0,0 +1,245 @@
+package sentry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Issue alert form field types.
+const (
+	IssueAlertFormFieldTypeChoice = "choice"
+	IssueAlertFormFieldTypeString = "string"
+	IssueAlertFormFieldTypeNumber = "number"
+)
+
+// IssueAlertConfiguration describes the conditions, filters and actions
+// available to the issue alerts of a project.
+// https://github.com/getsentry/sentry/blob/24.10.0/src/sentry/api/endpoints/project_rules_configuration.py
+type IssueAlertConfiguration struct {
+	Actions    []*IssueAlertRuleDescriptor `json:"actions,omitempty"`
+	Conditions []*IssueAlertRuleDescriptor `json:"conditions,omitempty"`
+	Filters    []*IssueAlertRuleDescriptor `json:"filters,omitempty"`
+}
+
+// IssueAlertRuleDescriptor describes a condition, filter or action and the
+// form fields it accepts.
+type IssueAlertRuleDescriptor struct {
+	ID         *string                         `json:"id,omitempty"`
+	Label      *string                         `json:"label,omitempty"`
+	Prompt     *string                         `json:"prompt,omitempty"`
+	Enabled    *bool                           `json:"enabled,omitempty"`
+	ActionType *string                         `json:"actionType,omitempty"`
+	Service    *string                         `json:"service,omitempty"`
+	FormFields map[string]*IssueAlertFormField `json:"formFields,omitempty"`
+	// SentryAppInstallationUUID identifies the installation of sentry app
+	// actions, which all share the same ID.
+	SentryAppInstallationUUID *string `json:"sentryAppInstallationUuid,omitempty"`
+}
+
+// IssueAlertFormField describes a form field of a condition, filter or action.
+type IssueAlertFormField struct {
+	Type        *string                      `json:"type,omitempty"`
+	Choices     []*IssueAlertFormFieldChoice `json:"choices,omitempty"`
+	Placeholder interface{}                  `json:"placeholder,omitempty"`
+	Initial     interface{}                  `json:"initial,omitempty"`
+
+	// Raw holds the value of the field if it is not an object. Sentry apps
+	// describe their settings this way, e.g. "uri": "/v1/alert-rule".
+	Raw interface{} `json:"-"`
+}
+
+var _ json.Unmarshaler = (*IssueAlertFormField)(nil)
+var _ json.Marshaler = (*IssueAlertFormField)(nil)
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (f *IssueAlertFormField) UnmarshalJSON(data []byte) error {
+	var raw interface{}
+	if err := unmarshalUseNumber(data, &raw); err != nil {
+		return err
+	}
+	if _, ok := raw.(map[string]interface{}); !ok {
+		*f = IssueAlertFormField{Raw: raw}
+		return nil
+	}
+
+	type formField IssueAlertFormField
+	var v formField
+	if err := unmarshalUseNumber(data, &v); err != nil {
+		return err
+	}
+	*f = IssueAlertFormField(v)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f IssueAlertFormField) MarshalJSON() ([]byte, error) {
+	if f.Raw != nil {
+		return json.Marshal(f.Raw)
+	}
+	type formField IssueAlertFormField
+	return json.Marshal(formField(f))
+}
+
+// IssueAlertFormFieldChoice is a choice of a form field. Numeric values are
+// converted to strings.
+type IssueAlertFormFieldChoice struct {
+	Value string
+	Label string
+}
+
+var _ json.Unmarshaler = (*IssueAlertFormFieldChoice)(nil)
+var _ json.Marshaler = (*IssueAlertFormFieldChoice)(nil)
+
+// UnmarshalJSON implements json.Unmarshaler.
+// Choices are encoded as [value, label].
+func (c *IssueAlertFormFieldChoice) UnmarshalJSON(data []byte) error {
+	var pair []interface{}
+	if err := unmarshalUseNumber(data, &pair); err != nil {
+		return err
+	}
+	if len(pair) != 2 {
+		return fmt.Errorf("sentry: invalid form field choice: %s", data)
+	}
+	c.Value = issueAlertFieldValue(pair[0])
+	c.Label = issueAlertFieldValue(pair[1])
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c IssueAlertFormFieldChoice) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]string{c.Value, c.Label})
+}
+
+// GetConfiguration returns the conditions, filters and actions available to
+// the issue alerts of a project.
+func (s *IssueAlertsService) GetConfiguration(ctx context.Context, organizationSlug string, projectSlug string) (*IssueAlertConfiguration, *Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/rules/configuration/", organizationSlug, projectSlug)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	configuration := new(IssueAlertConfiguration)
+	resp, err := s.client.Do(ctx, req, configuration)
+	if err != nil {
+		return nil, resp, err
+	}
+	return configuration, resp, nil
+}
+
+// IssueAlertValidationError is returned by IssueAlertConfiguration.Validate
+// with every problem found in an issue alert.
+type IssueAlertValidationError struct {
+	Problems []string
+}
+
+func (e *IssueAlertValidationError) Error() string {
+	return fmt.Sprintf("sentry: invalid issue alert: %s", strings.Join(e.Problems, "; "))
+}
+
+// Validate checks locally that the conditions, filters and actions of an
+// issue alert are available, and that the values of their choice and number
+// fields are valid. It returns an *IssueAlertValidationError if not.
+func (c *IssueAlertConfiguration) Validate(alert *IssueAlert) error {
+	var problems []string
+	for i, condition := range alert.Conditions {
+		problems = append(problems, validateIssueAlertRule(c.Conditions, "conditions", i, condition)...)
+	}
+	for i, filter := range alert.Filters {
+		problems = append(problems, validateIssueAlertRule(c.Filters, "filters", i, filter)...)
+	}
+	for i, action := range alert.Actions {
+		problems = append(problems, validateIssueAlertRule(c.Actions, "actions", i, action)...)
+	}
+	if len(problems) > 0 {
+		return &IssueAlertValidationError{Problems: problems}
+	}
+	return nil
+}
+
+func validateIssueAlertRule(descriptors []*IssueAlertRuleDescriptor, kind string, i int, values map[string]interface{}) []string {
+	id := issueAlertRuleID(values)
+	installation, _ := values["sentryAppInstallationUuid"].(string)
+	prefix := fmt.Sprintf("%s[%d] %s", kind, i, id)
+	if installation != "" {
+		prefix += fmt.Sprintf(" (%s)", installation)
+	}
+
+	// Sentry app actions share an ID and are told apart by installation.
+	var descriptor *IssueAlertRuleDescriptor
+	for _, d := range descriptors {
+		var uuid string
+		if d.SentryAppInstallationUUID != nil {
+			uuid = *d.SentryAppInstallationUUID
+		}
+		if d.ID != nil && *d.ID == id && uuid == installation {
+			descriptor = d
+			break
+		}
+	}
+	if descriptor == nil {
+		return []string{fmt.Sprintf("%s: not available", prefix)}
+	}
+	if descriptor.Enabled != nil && !*descriptor.Enabled {
+		return []string{fmt.Sprintf("%s: not enabled", prefix)}
+	}
+
+	names := make([]string, 0, len(descriptor.FormFields))
+	for name := range descriptor.FormFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var problems []string
+	for _, name := range names {
+		field := descriptor.FormFields[name]
+		value, ok := values[name]
+		if field == nil || field.Type == nil || !ok || value == nil {
+			continue
+		}
+		s := issueAlertFieldValue(value)
+		if s == "" {
+			continue
+		}
+
+		switch *field.Type {
+		case IssueAlertFormFieldTypeChoice:
+			if len(field.Choices) == 0 || hasIssueAlertFormFieldChoice(field.Choices, s) {
+				continue
+			}
+			valid := make([]string, len(field.Choices))
+			for j, choice := range field.Choices {
+				valid[j] = fmt.Sprintf("%q", choice.Value)
+			}
+			problems = append(problems, fmt.Sprintf("%s: field %s: %q is not one of %s", prefix, name, s, strings.Join(valid, ", ")))
+		case IssueAlertFormFieldTypeNumber:
+			if _, err := json.Number(s).Float64(); err != nil {
+				problems = append(problems, fmt.Sprintf("%s: field %s: %q is not a number", prefix, name, s))
+			}
+		}
+	}
+	return problems
+}
+
+func hasIssueAlertFormFieldChoice(choices []*IssueAlertFormFieldChoice, value string) bool {
+	for _, choice := range choices {
+		if choice.Value == value {
+			return true
+		}
+	}
+	return false
+}
+
+func issueAlertFieldValue(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,282 @@
+package sentry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const issueAlertConfigurationJSON = `{
+	"actions": [
+		{
+			"id": "sentry.integrations.slack.notify_action.SlackNotifyServiceAction",
+			"label": "Send a notification to the {workspace} Slack workspace to {channel} (optionally, an ID: {channel_id}) and show tags {tags} in notification",
+			"enabled": true,
+			"prompt": "Send a Slack notification",
+			"formFields": {
+				"workspace": {"type": "choice", "choices": [["1234", "Dummy"]]},
+				"channel": {"type": "string", "placeholder": "i.e #critical, Jane Schmidt"},
+				"channel_id": {"type": "string", "placeholder": "i.e. CA2FRA079 or UA1J9RTE1"},
+				"tags": {"type": "string", "placeholder": "i.e environment,user,my_tag"}
+			}
+		},
+		{
+			"id": "sentry.rules.actions.notify_event_sentry_app.NotifyEventSentryAppAction",
+			"label": "Send a notification to Linear",
+			"enabled": true,
+			"service": "linear",
+			"actionType": "sentryapp",
+			"sentryAppInstallationUuid": "d9a1e0b4-linear",
+			"formFields": {
+				"type": "alert-rule-settings",
+				"uri": "/v1/alert-rule",
+				"required_fields": [{"type": "select", "name": "team"}]
+			}
+		},
+		{
+			"id": "sentry.rules.actions.notify_event_sentry_app.NotifyEventSentryAppAction",
+			"label": "Send a notification to Clubhouse",
+			"enabled": false,
+			"service": "clubhouse",
+			"actionType": "sentryapp",
+			"sentryAppInstallationUuid": "5c6f2a7e-clubhouse",
+			"formFields": {
+				"type": "alert-rule-settings",
+				"uri": "/v1/alert-rule"
+			}
+		},
+		{
+			"id": "sentry.integrations.pagerduty.notify_action.PagerDutyNotifyServiceAction",
+			"label": "Send a notification to PagerDuty account {account} and service {service}",
+			"enabled": false,
+			"formFields": {}
+		}
+	],
+	"conditions": [
+		{
+			"id": "sentry.rules.conditions.event_frequency.EventFrequencyCondition",
+			"label": "The issue is seen more than {value} times in {interval}",
+			"enabled": true,
+			"formFields": {
+				"value": {"type": "number", "placeholder": 100},
+				"interval": {"type": "choice", "choices": [["1m", "one minute"], ["1h", "one hour"]]}
+			}
+		}
+	],
+	"filters": [
+		{
+			"id": "sentry.rules.filters.level.LevelFilter",
+			"label": "The event's level is {match} {level}",
+			"enabled": true,
+			"formFields": {
+				"match": {"type": "choice", "choices": [["eq", "equal to"], ["gte", "greater than or equal to"]]},
+				"level": {"type": "choice", "choices": [[50, "fatal"], [40, "error"]]}
+			}
+		}
+	]
+}`
+
+func TestIssueAlertsService_GetConfiguration(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/rules/configuration/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, issueAlertConfigurationJSON)
+	})
+
+	ctx := context.Background()
+	configuration, _, err := client.IssueAlerts.GetConfiguration(ctx, "the-interstellar-jurisdiction", "pump-station")
+	require.NoError(t, err)
+
+	expected := &IssueAlertConfiguration{
+		Actions: []*IssueAlertRuleDescriptor{
+			{
+				ID:      String(IssueAlertActionSlackNotifyService),
+				Label:   String("Send a notification to the {workspace} Slack workspace to {channel} (optionally, an ID: {channel_id}) and show tags {tags} in notification"),
+				Enabled: Bool(true),
+				Prompt:  String("Send a Slack notification"),
+				FormFields: map[string]*IssueAlertFormField{
+					"workspace": {
+						Type:    String(IssueAlertFormFieldTypeChoice),
+						Choices: []*IssueAlertFormFieldChoice{{Value: "1234", Label: "Dummy"}},
+					},
+					"channel":    {Type: String(IssueAlertFormFieldTypeString), Placeholder: "i.e #critical, Jane Schmidt"},
+					"channel_id": {Type: String(IssueAlertFormFieldTypeString), Placeholder: "i.e. CA2FRA079 or UA1J9RTE1"},
+					"tags":       {Type: String(IssueAlertFormFieldTypeString), Placeholder: "i.e environment,user,my_tag"},
+				},
+			},
+			{
+				ID:         String("sentry.rules.actions.notify_event_sentry_app.NotifyEventSentryAppAction"),
+				Label:      String("Send a notification to Linear"),
+				Enabled:    Bool(true),
+				Service:    String("linear"),
+				ActionType: String("sentryapp"),
+				FormFields: map[string]*IssueAlertFormField{
+					"type": {Raw: "alert-rule-settings"},
+					"uri":  {Raw: "/v1/alert-rule"},
+					"required_fields": {
+						Raw: []interface{}{
+							map[string]interface{}{"type": "select", "name": "team"},
+						},
+					},
+				},
+				SentryAppInstallationUUID: String("d9a1e0b4-linear"),
+			},
+			{
+				ID:         String("sentry.rules.actions.notify_event_sentry_app.NotifyEventSentryAppAction"),
+				Label:      String("Send a notification to Clubhouse"),
+				Enabled:    Bool(false),
+				Service:    String("clubhouse"),
+				ActionType: String("sentryapp"),
+				FormFields: map[string]*IssueAlertFormField{
+					"type": {Raw: "alert-rule-settings"},
+					"uri":  {Raw: "/v1/alert-rule"},
+				},
+				SentryAppInstallationUUID: String("5c6f2a7e-clubhouse"),
+			},
+			{
+				ID:         String(IssueAlertActionPagerDutyNotifyService),
+				Label:      String("Send a notification to PagerDuty account {account} and service {service}"),
+				Enabled:    Bool(false),
+				FormFields: map[string]*IssueAlertFormField{},
+			},
+		},
+		Conditions: []*IssueAlertRuleDescriptor{
+			{
+				ID:      String(IssueAlertConditionEventFrequency),
+				Label:   String("The issue is seen more than {value} times in {interval}"),
+				Enabled: Bool(true),
+				FormFields: map[string]*IssueAlertFormField{
+					"value": {Type: String(IssueAlertFormFieldTypeNumber), Placeholder: json.Number("100")},
+					"interval": {
+						Type: String(IssueAlertFormFieldTypeChoice),
+						Choices: []*IssueAlertFormFieldChoice{
+							{Value: "1m", Label: "one minute"},
+							{Value: "1h", Label: "one hour"},
+						},
+					},
+				},
+			},
+		},
+		Filters: []*IssueAlertRuleDescriptor{
+			{
+				ID:      String(IssueAlertFilterLevel),
+				Label:   String("The event's level is {match} {level}"),
+				Enabled: Bool(true),
+				FormFields: map[string]*IssueAlertFormField{
+					"match": {
+						Type: String(IssueAlertFormFieldTypeChoice),
+						Choices: []*IssueAlertFormFieldChoice{
+							{Value: "eq", Label: "equal to"},
+							{Value: "gte", Label: "greater than or equal to"},
+						},
+					},
+					"level": {
+						Type: String(IssueAlertFormFieldTypeChoice),
+						Choices: []*IssueAlertFormFieldChoice{
+							{Value: "50", Label: "fatal"},
+							{Value: "40", Label: "error"},
+						},
+					},
+				},
+			},
+		},
+	}
+	assert.Equal(t, expected, configuration)
+}
+
+func TestIssueAlertConfiguration_Validate(t *testing.T) {
+	var configuration IssueAlertConfiguration
+	require.NoError(t, json.Unmarshal([]byte(issueAlertConfigurationJSON), &configuration))
+
+	alert := &IssueAlert{
+		Conditions: []map[string]interface{}{
+			{
+				"id":       IssueAlertConditionEventFrequency,
+				"value":    json.Number("100"),
+				"interval": "1h",
+			},
+		},
+		Filters: []map[string]interface{}{
+			{
+				"id":    IssueAlertFilterLevel,
+				"match": "gte",
+				"level": "40",
+			},
+		},
+		Actions: []map[string]interface{}{
+			{
+				"id":        IssueAlertActionSlackNotifyService,
+				"workspace": json.Number("1234"),
+				"channel":   "#alerts",
+			},
+			{
+				"id":                        "sentry.rules.actions.notify_event_sentry_app.NotifyEventSentryAppAction",
+				"sentryAppInstallationUuid": "d9a1e0b4-linear",
+				"settings":                  []interface{}{},
+			},
+		},
+	}
+	assert.NoError(t, configuration.Validate(alert))
+
+	alert = &IssueAlert{
+		Conditions: []map[string]interface{}{
+			{
+				"id":       IssueAlertConditionEventFrequency,
+				"value":    "lots",
+				"interval": "2h",
+			},
+			{"id": IssueAlertConditionFirstSeenEvent},
+		},
+		Filters: []map[string]interface{}{
+			{"id": IssueAlertFilterLevel, "match": "lt"},
+		},
+		Actions: []map[string]interface{}{
+			{"id": IssueAlertActionSlackNotifyService, "workspace": json.Number("999")},
+			{"id": IssueAlertActionPagerDutyNotifyService},
+			{
+				"id":                        "sentry.rules.actions.notify_event_sentry_app.NotifyEventSentryAppAction",
+				"sentryAppInstallationUuid": "5c6f2a7e-clubhouse",
+			},
+			{
+				"id":                        "sentry.rules.actions.notify_event_sentry_app.NotifyEventSentryAppAction",
+				"sentryAppInstallationUuid": "0000-uninstalled",
+			},
+		},
+	}
+	err := configuration.Validate(alert)
+	require.Error(t, err)
+
+	var validationErr *IssueAlertValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, []string{
+		`conditions[0] sentry.rules.conditions.event_frequency.EventFrequencyCondition: field interval: "2h" is not one of "1m", "1h"`,
+		`conditions[0] sentry.rules.conditions.event_frequency.EventFrequencyCondition: field value: "lots" is not a number`,
+		`conditions[1] sentry.rules.conditions.first_seen_event.FirstSeenEventCondition: not available`,
+		`filters[0] sentry.rules.filters.level.LevelFilter: field match: "lt" is not one of "eq", "gte"`,
+		`actions[0] sentry.integrations.slack.notify_action.SlackNotifyServiceAction: field workspace: "999" is not one of "1234"`,
+		`actions[1] sentry.integrations.pagerduty.notify_action.PagerDutyNotifyServiceAction: not enabled`,
+		`actions[2] sentry.rules.actions.notify_event_sentry_app.NotifyEventSentryAppAction (5c6f2a7e-clubhouse): not enabled`,
+		`actions[3] sentry.rules.actions.notify_event_sentry_app.NotifyEventSentryAppAction (0000-uninstalled): not available`,
+	}, validationErr.Problems)
+}
+
+func TestIssueAlertFormField_UnmarshalJSON(t *testing.T) {
+	var field IssueAlertFormField
+	require.NoError(t, json.Unmarshal([]byte(`"alert-rule-settings"`), &field))
+	assert.Equal(t, IssueAlertFormField{Raw: "alert-rule-settings"}, field)
+
+	b, err := json.Marshal(field)
+	require.NoError(t, err)
+	assert.JSONEq(t, `"alert-rule-settings"`, string(b))
+
+	var invalid IssueAlertFormField
+	assert.Error(t, json.Unmarshal([]byte(`{"type": "choice", "choices": "eq"}`), &invalid))
+}
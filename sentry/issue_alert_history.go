@@ -0,0 +1,97 @@
+package sentry
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// IssueAlertGroupHistory represents an issue that triggered an issue alert.
+// https://github.com/getsentry/sentry/blob/24.10.0/src/sentry/api/serializers/models/rule_group_history.py
+type IssueAlertGroupHistory struct {
+	Group *Issue `json:"group,omitempty"`
+	// Count is the number of times the issue triggered the alert.
+	Count         *int       `json:"count,omitempty"`
+	LastTriggered *time.Time `json:"lastTriggered,omitempty"`
+	// EventID is the ID of the last event that triggered the alert, if known.
+	EventID *string `json:"eventId,omitempty"`
+}
+
+// IssueAlertStat is the number of times an issue alert fired in a time
+// bucket.
+type IssueAlertStat struct {
+	Date  *time.Time `json:"date,omitempty"`
+	Count *int       `json:"count,omitempty"`
+}
+
+// IssueAlertStatsParams are the parameters for IssueAlertsService.Stats.
+// Sentry defaults to the last 14 days if no period is given.
+type IssueAlertStatsParams struct {
+	StatsPeriod *string    `url:"statsPeriod,omitempty"`
+	Start       *time.Time `url:"start,omitempty"`
+	End         *time.Time `url:"end,omitempty"`
+}
+
+// IssueAlertGroupHistoryParams are the parameters for
+// IssueAlertsService.ListGroupHistory.
+type IssueAlertGroupHistoryParams struct {
+	ListCursorParams
+	IssueAlertStatsParams
+}
+
+// ListGroupHistory lists the issues that triggered an issue alert over a
+// period, with the most frequent first.
+func (s *IssueAlertsService) ListGroupHistory(ctx context.Context, organizationSlug string, projectSlug string, issueAlertID string, params *IssueAlertGroupHistoryParams) ([]*IssueAlertGroupHistory, *Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/rules/%v/group-history/", organizationSlug, projectSlug, issueAlertID)
+	u, err := addQuery(u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	history := []*IssueAlertGroupHistory{}
+	resp, err := s.client.Do(ctx, req, &history)
+	if err != nil {
+		return nil, resp, err
+	}
+	return history, resp, nil
+}
+
+// ListAllGroupHistory returns all the issues that triggered an issue alert
+// over a period, following pagination.
+func (s *IssueAlertsService) ListAllGroupHistory(ctx context.Context, organizationSlug string, projectSlug string, issueAlertID string, params *IssueAlertGroupHistoryParams) ([]*IssueAlertGroupHistory, *Response, error) {
+	p := IssueAlertGroupHistoryParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewPager(func(ctx context.Context, cursor string) ([]*IssueAlertGroupHistory, *Response, error) {
+		p.Cursor = cursor
+		return s.ListGroupHistory(ctx, organizationSlug, projectSlug, issueAlertID, &p)
+	}).All(ctx)
+}
+
+// Stats returns how many times an issue alert fired over a period, bucketed
+// by hour.
+func (s *IssueAlertsService) Stats(ctx context.Context, organizationSlug string, projectSlug string, issueAlertID string, params *IssueAlertStatsParams) ([]*IssueAlertStat, *Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/rules/%v/stats/", organizationSlug, projectSlug, issueAlertID)
+	u, err := addQuery(u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stats := []*IssueAlertStat{}
+	resp, err := s.client.Do(ctx, req, &stats)
+	if err != nil {
+		return nil, resp, err
+	}
+	return stats, resp, nil
+}
@@ -0,0 +1,104 @@
+package sentry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssueAlertsService_ListGroupHistory(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/rules/12345/group-history/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"statsPeriod": "7d"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[
+			{
+				"group": {
+					"id": "1",
+					"shortId": "PUMP-STATION-1",
+					"title": "This is an example Python exception"
+				},
+				"count": 12,
+				"lastTriggered": "2024-10-17T09:30:00Z",
+				"eventId": "9999aaaaca8b46d797c23c6077c6ff01"
+			},
+			{
+				"group": {
+					"id": "2",
+					"shortId": "PUMP-STATION-2",
+					"title": "TypeError: cannot read property"
+				},
+				"count": 1,
+				"lastTriggered": "2024-10-16T10:00:00Z",
+				"eventId": null
+			}
+		]`)
+	})
+
+	ctx := context.Background()
+	history, _, err := client.IssueAlerts.ListGroupHistory(ctx, "the-interstellar-jurisdiction", "pump-station", "12345", &IssueAlertGroupHistoryParams{
+		IssueAlertStatsParams: IssueAlertStatsParams{StatsPeriod: String("7d")},
+	})
+	require.NoError(t, err)
+
+	expected := []*IssueAlertGroupHistory{
+		{
+			Group: &Issue{
+				ID:      String("1"),
+				ShortID: String("PUMP-STATION-1"),
+				Title:   String("This is an example Python exception"),
+			},
+			Count:         Int(12),
+			LastTriggered: Time(mustParseTime("2024-10-17T09:30:00Z")),
+			EventID:       String("9999aaaaca8b46d797c23c6077c6ff01"),
+		},
+		{
+			Group: &Issue{
+				ID:      String("2"),
+				ShortID: String("PUMP-STATION-2"),
+				Title:   String("TypeError: cannot read property"),
+			},
+			Count:         Int(1),
+			LastTriggered: Time(mustParseTime("2024-10-16T10:00:00Z")),
+		},
+	}
+	assert.Equal(t, expected, history)
+}
+
+func TestIssueAlertsService_Stats(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/rules/12345/stats/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{
+			"start": "2024-10-17T00:00:00Z",
+			"end":   "2024-10-17T02:00:00Z",
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[
+			{"date": "2024-10-17T00:00:00Z", "count": 3},
+			{"date": "2024-10-17T01:00:00Z", "count": 0}
+		]`)
+	})
+
+	ctx := context.Background()
+	stats, _, err := client.IssueAlerts.Stats(ctx, "the-interstellar-jurisdiction", "pump-station", "12345", &IssueAlertStatsParams{
+		Start: Time(mustParseTime("2024-10-17T00:00:00Z")),
+		End:   Time(mustParseTime("2024-10-17T02:00:00Z")),
+	})
+	require.NoError(t, err)
+
+	expected := []*IssueAlertStat{
+		{Date: Time(mustParseTime("2024-10-17T00:00:00Z")), Count: Int(3)},
+		{Date: Time(mustParseTime("2024-10-17T01:00:00Z")), Count: Int(0)},
+	}
+	assert.Equal(t, expected, stats)
+}
@@ -0,0 +1,793 @@
+package sentry
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// Issue alert condition IDs.
+const (
+	IssueAlertConditionFirstSeenEvent            = "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition"
+	IssueAlertConditionRegressionEvent           = "sentry.rules.conditions.regression_event.RegressionEventCondition"
+	IssueAlertConditionReappearedEvent           = "sentry.rules.conditions.reappeared_event.ReappearedEventCondition"
+	IssueAlertConditionNewHighPriorityIssue      = "sentry.rules.conditions.high_priority_issue.NewHighPriorityIssueCondition"
+	IssueAlertConditionExistingHighPriorityIssue = "sentry.rules.conditions.high_priority_issue.ExistingHighPriorityIssueCondition"
+	IssueAlertConditionEventFrequency            = "sentry.rules.conditions.event_frequency.EventFrequencyCondition"
+	IssueAlertConditionEventUniqueUserFrequency  = "sentry.rules.conditions.event_frequency.EventUniqueUserFrequencyCondition"
+	IssueAlertConditionEventFrequencyPercent     = "sentry.rules.conditions.event_frequency.EventFrequencyPercentCondition"
+)
+
+// Issue alert filter IDs.
+const (
+	IssueAlertFilterAgeComparison    = "sentry.rules.filters.age_comparison.AgeComparisonFilter"
+	IssueAlertFilterIssueOccurrences = "sentry.rules.filters.issue_occurrences.IssueOccurrencesFilter"
+	IssueAlertFilterAssignedTo       = "sentry.rules.filters.assigned_to.AssignedToFilter"
+	IssueAlertFilterLatestRelease    = "sentry.rules.filters.latest_release.LatestReleaseFilter"
+	IssueAlertFilterIssueCategory    = "sentry.rules.filters.issue_category.IssueCategoryFilter"
+	IssueAlertFilterEventAttribute   = "sentry.rules.filters.event_attribute.EventAttributeFilter"
+	IssueAlertFilterTaggedEvent      = "sentry.rules.filters.tagged_event.TaggedEventFilter"
+	IssueAlertFilterLevel            = "sentry.rules.filters.level.LevelFilter"
+)
+
+// Issue alert action IDs.
+const (
+	IssueAlertActionNotifyEmail            = "sentry.mail.actions.NotifyEmailAction"
+	IssueAlertActionNotifyEvent            = "sentry.rules.actions.notify_event.NotifyEventAction"
+	IssueAlertActionNotifyEventService     = "sentry.rules.actions.notify_event_service.NotifyEventServiceAction"
+	IssueAlertActionSlackNotifyService     = "sentry.integrations.slack.notify_action.SlackNotifyServiceAction"
+	IssueAlertActionPagerDutyNotifyService = "sentry.integrations.pagerduty.notify_action.PagerDutyNotifyServiceAction"
+	IssueAlertActionOpsgenieNotifyTeam     = "sentry.integrations.opsgenie.notify_action.OpsgenieNotifyTeamAction"
+	IssueAlertActionMsTeamsNotifyService   = "sentry.integrations.msteams.notify_action.MsTeamsNotifyServiceAction"
+	IssueAlertActionDiscordNotifyService   = "sentry.integrations.discord.notify_action.DiscordNotifyServiceAction"
+	IssueAlertActionJiraCreateTicket       = "sentry.integrations.jira.notify_action.JiraCreateTicketAction"
+	IssueAlertActionJiraServerCreateTicket = "sentry.integrations.jira_server.notify_action.JiraServerCreateTicketAction"
+)
+
+// FirstSeenEventCondition triggers when an issue is first seen.
+type FirstSeenEventCondition struct {
+	Name  *string                `json:"name,omitempty"`
+	Extra map[string]interface{} `json:"-"`
+}
+
+// RegressionEventCondition triggers when an issue changes state from resolved to unresolved.
+type RegressionEventCondition struct {
+	Name  *string                `json:"name,omitempty"`
+	Extra map[string]interface{} `json:"-"`
+}
+
+// ReappearedEventCondition triggers when an issue changes state from ignored to unresolved.
+type ReappearedEventCondition struct {
+	Name  *string                `json:"name,omitempty"`
+	Extra map[string]interface{} `json:"-"`
+}
+
+// NewHighPriorityIssueCondition triggers when Sentry marks a new issue as high priority.
+type NewHighPriorityIssueCondition struct {
+	Name  *string                `json:"name,omitempty"`
+	Extra map[string]interface{} `json:"-"`
+}
+
+// ExistingHighPriorityIssueCondition triggers when Sentry marks an existing issue as high priority.
+type ExistingHighPriorityIssueCondition struct {
+	Name  *string                `json:"name,omitempty"`
+	Extra map[string]interface{} `json:"-"`
+}
+
+// EventFrequencyCondition triggers when an issue is seen more than Value
+// times in Interval, or, with the "percent" comparison type, when it is seen
+// Value percent more than in the preceding ComparisonInterval.
+type EventFrequencyCondition struct {
+	Name               *string                `json:"name,omitempty"`
+	ComparisonType     *string                `json:"comparisonType,omitempty"`
+	ComparisonInterval *string                `json:"comparisonInterval,omitempty"`
+	Value              *json.Number           `json:"value,omitempty"`
+	Interval           *string                `json:"interval,omitempty"`
+	Extra              map[string]interface{} `json:"-"`
+}
+
+// EventUniqueUserFrequencyCondition triggers when an issue is seen by more
+// than Value users in Interval.
+type EventUniqueUserFrequencyCondition struct {
+	Name               *string                `json:"name,omitempty"`
+	ComparisonType     *string                `json:"comparisonType,omitempty"`
+	ComparisonInterval *string                `json:"comparisonInterval,omitempty"`
+	Value              *json.Number           `json:"value,omitempty"`
+	Interval           *string                `json:"interval,omitempty"`
+	Extra              map[string]interface{} `json:"-"`
+}
+
+// EventFrequencyPercentCondition triggers when an issue affects more than
+// Value percent of sessions in Interval.
+type EventFrequencyPercentCondition struct {
+	Name               *string                `json:"name,omitempty"`
+	ComparisonType     *string                `json:"comparisonType,omitempty"`
+	ComparisonInterval *string                `json:"comparisonInterval,omitempty"`
+	Value              *json.Number           `json:"value,omitempty"`
+	Interval           *string                `json:"interval,omitempty"`
+	Extra              map[string]interface{} `json:"-"`
+}
+
+// IssueAlertCondition is a condition of an issue alert. Exactly one field is
+// set: the typed field matching a built-in condition, or Unknown, holding the
+// condition as sent by Sentry, for any other condition. The keys a typed field
+// does not model are kept in its Extra field, so conditions fetched from
+// Sentry are sent back unchanged.
+type IssueAlertCondition struct {
+	FirstSeenEvent            *FirstSeenEventCondition
+	RegressionEvent           *RegressionEventCondition
+	ReappearedEvent           *ReappearedEventCondition
+	NewHighPriorityIssue      *NewHighPriorityIssueCondition
+	ExistingHighPriorityIssue *ExistingHighPriorityIssueCondition
+	EventFrequency            *EventFrequencyCondition
+	EventUniqueUserFrequency  *EventUniqueUserFrequencyCondition
+	EventFrequencyPercent     *EventFrequencyPercentCondition
+	Unknown                   map[string]interface{}
+}
+
+var _ json.Unmarshaler = (*IssueAlertCondition)(nil)
+var _ json.Marshaler = (*IssueAlertCondition)(nil)
+
+func (c IssueAlertCondition) rule() (string, interface{}) {
+	switch {
+	case c.FirstSeenEvent != nil:
+		return IssueAlertConditionFirstSeenEvent, c.FirstSeenEvent
+	case c.RegressionEvent != nil:
+		return IssueAlertConditionRegressionEvent, c.RegressionEvent
+	case c.ReappearedEvent != nil:
+		return IssueAlertConditionReappearedEvent, c.ReappearedEvent
+	case c.NewHighPriorityIssue != nil:
+		return IssueAlertConditionNewHighPriorityIssue, c.NewHighPriorityIssue
+	case c.ExistingHighPriorityIssue != nil:
+		return IssueAlertConditionExistingHighPriorityIssue, c.ExistingHighPriorityIssue
+	case c.EventFrequency != nil:
+		return IssueAlertConditionEventFrequency, c.EventFrequency
+	case c.EventUniqueUserFrequency != nil:
+		return IssueAlertConditionEventUniqueUserFrequency, c.EventUniqueUserFrequency
+	case c.EventFrequencyPercent != nil:
+		return IssueAlertConditionEventFrequencyPercent, c.EventFrequencyPercent
+	}
+	return issueAlertRuleID(c.Unknown), nil
+}
+
+// ID returns the ID of the condition.
+func (c IssueAlertCondition) ID() string {
+	id, _ := c.rule()
+	return id
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *IssueAlertCondition) UnmarshalJSON(data []byte) error {
+	raw, err := decodeIssueAlertRule(data)
+	if err != nil || raw == nil {
+		return err
+	}
+
+	*c = IssueAlertCondition{}
+	var v interface{}
+	switch issueAlertRuleID(raw) {
+	case IssueAlertConditionFirstSeenEvent:
+		c.FirstSeenEvent = new(FirstSeenEventCondition)
+		v = c.FirstSeenEvent
+	case IssueAlertConditionRegressionEvent:
+		c.RegressionEvent = new(RegressionEventCondition)
+		v = c.RegressionEvent
+	case IssueAlertConditionReappearedEvent:
+		c.ReappearedEvent = new(ReappearedEventCondition)
+		v = c.ReappearedEvent
+	case IssueAlertConditionNewHighPriorityIssue:
+		c.NewHighPriorityIssue = new(NewHighPriorityIssueCondition)
+		v = c.NewHighPriorityIssue
+	case IssueAlertConditionExistingHighPriorityIssue:
+		c.ExistingHighPriorityIssue = new(ExistingHighPriorityIssueCondition)
+		v = c.ExistingHighPriorityIssue
+	case IssueAlertConditionEventFrequency:
+		c.EventFrequency = new(EventFrequencyCondition)
+		v = c.EventFrequency
+	case IssueAlertConditionEventUniqueUserFrequency:
+		c.EventUniqueUserFrequency = new(EventUniqueUserFrequencyCondition)
+		v = c.EventUniqueUserFrequency
+	case IssueAlertConditionEventFrequencyPercent:
+		c.EventFrequencyPercent = new(EventFrequencyPercentCondition)
+		v = c.EventFrequencyPercent
+	default:
+		c.Unknown = raw
+		return nil
+	}
+	return unmarshalIssueAlertRule(data, raw, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c IssueAlertCondition) MarshalJSON() ([]byte, error) {
+	id, v := c.rule()
+	if v == nil {
+		return json.Marshal(c.Unknown)
+	}
+	return marshalIssueAlertRule(id, v)
+}
+
+// AgeComparisonFilter matches issues older or newer than Value units of Time.
+type AgeComparisonFilter struct {
+	Name *string `json:"name,omitempty"`
+	// ComparisonType is "older" or "newer".
+	ComparisonType *string      `json:"comparison_type,omitempty"`
+	Value          *json.Number `json:"value,omitempty"`
+	// Time is "minute", "hour", "day" or "week".
+	Time  *string                `json:"time,omitempty"`
+	Extra map[string]interface{} `json:"-"`
+}
+
+// IssueOccurrencesFilter matches issues that happened at least Value times.
+type IssueOccurrencesFilter struct {
+	Name  *string                `json:"name,omitempty"`
+	Value *json.Number           `json:"value,omitempty"`
+	Extra map[string]interface{} `json:"-"`
+}
+
+// AssignedToFilter matches issues assigned to a team or member, or unassigned issues.
+type AssignedToFilter struct {
+	Name *string `json:"name,omitempty"`
+	// TargetType is "Unassigned", "Team" or "Member".
+	TargetType       *string                `json:"targetType,omitempty"`
+	TargetIdentifier *Int64OrString         `json:"targetIdentifier,omitempty"`
+	Extra            map[string]interface{} `json:"-"`
+}
+
+// LatestReleaseFilter matches events from the latest release.
+type LatestReleaseFilter struct {
+	Name  *string                `json:"name,omitempty"`
+	Extra map[string]interface{} `json:"-"`
+}
+
+// IssueCategoryFilter matches issues of a category.
+type IssueCategoryFilter struct {
+	Name  *string                `json:"name,omitempty"`
+	Value *json.Number           `json:"value,omitempty"`
+	Extra map[string]interface{} `json:"-"`
+}
+
+// EventAttributeFilter matches events whose Attribute matches Value.
+type EventAttributeFilter struct {
+	Name      *string `json:"name,omitempty"`
+	Attribute *string `json:"attribute,omitempty"`
+	// Match is the match type, such as "eq", "ne", "sw", "ew", "co", "nc", "is" or "ns".
+	Match *string                `json:"match,omitempty"`
+	Value *string                `json:"value,omitempty"`
+	Extra map[string]interface{} `json:"-"`
+}
+
+// TaggedEventFilter matches events whose Key tag matches Value.
+type TaggedEventFilter struct {
+	Name *string `json:"name,omitempty"`
+	Key  *string `json:"key,omitempty"`
+	// Match is the match type, such as "eq", "ne", "sw", "ew", "co", "nc", "is" or "ns".
+	Match *string                `json:"match,omitempty"`
+	Value *string                `json:"value,omitempty"`
+	Extra map[string]interface{} `json:"-"`
+}
+
+// LevelFilter matches events by level.
+type LevelFilter struct {
+	Name *string `json:"name,omitempty"`
+	// Match is "eq", "gte" or "lte".
+	Match *string `json:"match,omitempty"`
+	// Level is the numeric level, such as "40" for error or "50" for fatal.
+	Level *Int64OrString         `json:"level,omitempty"`
+	Extra map[string]interface{} `json:"-"`
+}
+
+// IssueAlertFilter is a filter of an issue alert. Exactly one field is set:
+// the typed field matching a built-in filter, or Unknown, holding the filter
+// as sent by Sentry, for any other filter. As with IssueAlertCondition, the
+// keys a typed field does not model are kept in its Extra field.
+type IssueAlertFilter struct {
+	AgeComparison    *AgeComparisonFilter
+	IssueOccurrences *IssueOccurrencesFilter
+	AssignedTo       *AssignedToFilter
+	LatestRelease    *LatestReleaseFilter
+	IssueCategory    *IssueCategoryFilter
+	EventAttribute   *EventAttributeFilter
+	TaggedEvent      *TaggedEventFilter
+	Level            *LevelFilter
+	Unknown          map[string]interface{}
+}
+
+var _ json.Unmarshaler = (*IssueAlertFilter)(nil)
+var _ json.Marshaler = (*IssueAlertFilter)(nil)
+
+func (f IssueAlertFilter) rule() (string, interface{}) {
+	switch {
+	case f.AgeComparison != nil:
+		return IssueAlertFilterAgeComparison, f.AgeComparison
+	case f.IssueOccurrences != nil:
+		return IssueAlertFilterIssueOccurrences, f.IssueOccurrences
+	case f.AssignedTo != nil:
+		return IssueAlertFilterAssignedTo, f.AssignedTo
+	case f.LatestRelease != nil:
+		return IssueAlertFilterLatestRelease, f.LatestRelease
+	case f.IssueCategory != nil:
+		return IssueAlertFilterIssueCategory, f.IssueCategory
+	case f.EventAttribute != nil:
+		return IssueAlertFilterEventAttribute, f.EventAttribute
+	case f.TaggedEvent != nil:
+		return IssueAlertFilterTaggedEvent, f.TaggedEvent
+	case f.Level != nil:
+		return IssueAlertFilterLevel, f.Level
+	}
+	return issueAlertRuleID(f.Unknown), nil
+}
+
+// ID returns the ID of the filter.
+func (f IssueAlertFilter) ID() string {
+	id, _ := f.rule()
+	return id
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (f *IssueAlertFilter) UnmarshalJSON(data []byte) error {
+	raw, err := decodeIssueAlertRule(data)
+	if err != nil || raw == nil {
+		return err
+	}
+
+	*f = IssueAlertFilter{}
+	var v interface{}
+	switch issueAlertRuleID(raw) {
+	case IssueAlertFilterAgeComparison:
+		f.AgeComparison = new(AgeComparisonFilter)
+		v = f.AgeComparison
+	case IssueAlertFilterIssueOccurrences:
+		f.IssueOccurrences = new(IssueOccurrencesFilter)
+		v = f.IssueOccurrences
+	case IssueAlertFilterAssignedTo:
+		f.AssignedTo = new(AssignedToFilter)
+		v = f.AssignedTo
+	case IssueAlertFilterLatestRelease:
+		f.LatestRelease = new(LatestReleaseFilter)
+		v = f.LatestRelease
+	case IssueAlertFilterIssueCategory:
+		f.IssueCategory = new(IssueCategoryFilter)
+		v = f.IssueCategory
+	case IssueAlertFilterEventAttribute:
+		f.EventAttribute = new(EventAttributeFilter)
+		v = f.EventAttribute
+	case IssueAlertFilterTaggedEvent:
+		f.TaggedEvent = new(TaggedEventFilter)
+		v = f.TaggedEvent
+	case IssueAlertFilterLevel:
+		f.Level = new(LevelFilter)
+		v = f.Level
+	default:
+		f.Unknown = raw
+		return nil
+	}
+	return unmarshalIssueAlertRule(data, raw, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f IssueAlertFilter) MarshalJSON() ([]byte, error) {
+	id, v := f.rule()
+	if v == nil {
+		return json.Marshal(f.Unknown)
+	}
+	return marshalIssueAlertRule(id, v)
+}
+
+// NotifyEmailAction sends an email to the issue owners, a team or a member.
+type NotifyEmailAction struct {
+	Name *string `json:"name,omitempty"`
+	// TargetType is "IssueOwners", "Team" or "Member".
+	TargetType       *string        `json:"targetType,omitempty"`
+	TargetIdentifier *Int64OrString `json:"targetIdentifier,omitempty"`
+	// FallthroughType is who to notify when there are no issue owners:
+	// "AllMembers", "ActiveMembers" or "NoOne".
+	FallthroughType *string                `json:"fallthroughType,omitempty"`
+	Extra           map[string]interface{} `json:"-"`
+}
+
+// NotifyEventAction sends a notification to all legacy integrations.
+type NotifyEventAction struct {
+	Name  *string                `json:"name,omitempty"`
+	Extra map[string]interface{} `json:"-"`
+}
+
+// NotifyEventServiceAction sends a notification through a service, such as
+// "webhooks" or the slug of a plugin or Sentry app.
+type NotifyEventServiceAction struct {
+	Name    *string                `json:"name,omitempty"`
+	Service *string                `json:"service,omitempty"`
+	Extra   map[string]interface{} `json:"-"`
+}
+
+// SlackNotifyServiceAction sends a notification to a Slack channel.
+type SlackNotifyServiceAction struct {
+	Name *string `json:"name,omitempty"`
+	// Workspace is the ID of the Slack integration.
+	Workspace *Int64OrString `json:"workspace,omitempty"`
+	Channel   *string        `json:"channel,omitempty"`
+	ChannelID *string        `json:"channel_id,omitempty"`
+	// Tags is a comma-separated list of tags to show in the notification.
+	Tags  *string                `json:"tags,omitempty"`
+	Notes *string                `json:"notes,omitempty"`
+	Extra map[string]interface{} `json:"-"`
+}
+
+// PagerDutyNotifyServiceAction sends a notification to a PagerDuty service.
+type PagerDutyNotifyServiceAction struct {
+	Name *string `json:"name,omitempty"`
+	// Account is the ID of the PagerDuty integration.
+	Account *Int64OrString `json:"account,omitempty"`
+	Service *Int64OrString `json:"service,omitempty"`
+	// Severity is "default", "critical", "warning", "error" or "info".
+	Severity *string                `json:"severity,omitempty"`
+	Extra    map[string]interface{} `json:"-"`
+}
+
+// OpsgenieNotifyTeamAction sends a notification to an Opsgenie team.
+type OpsgenieNotifyTeamAction struct {
+	Name *string `json:"name,omitempty"`
+	// Account is the ID of the Opsgenie integration.
+	Account *Int64OrString `json:"account,omitempty"`
+	Team    *string        `json:"team,omitempty"`
+	// Priority is "P1" to "P5".
+	Priority *string                `json:"priority,omitempty"`
+	Extra    map[string]interface{} `json:"-"`
+}
+
+// MsTeamsNotifyServiceAction sends a notification to a Microsoft Teams channel.
+type MsTeamsNotifyServiceAction struct {
+	Name *string `json:"name,omitempty"`
+	// Team is the ID of the Microsoft Teams integration.
+	Team    *Int64OrString         `json:"team,omitempty"`
+	Channel *string                `json:"channel,omitempty"`
+	Extra   map[string]interface{} `json:"-"`
+}
+
+// DiscordNotifyServiceAction sends a notification to a Discord channel.
+type DiscordNotifyServiceAction struct {
+	Name *string `json:"name,omitempty"`
+	// Server is the ID of the Discord integration.
+	Server    *Int64OrString `json:"server,omitempty"`
+	ChannelID *string        `json:"channel_id,omitempty"`
+	// Tags is a comma-separated list of tags to show in the notification.
+	Tags  *string                `json:"tags,omitempty"`
+	Extra map[string]interface{} `json:"-"`
+}
+
+// JiraCreateTicketAction creates a Jira issue. The fields of the issue depend
+// on the Jira project and issue type, so any field other than those below is
+// kept in Fields.
+type JiraCreateTicketAction struct {
+	Name *string
+	// Integration is the ID of the Jira integration.
+	Integration       *Int64OrString
+	Project           *string
+	IssueType         *string
+	DynamicFormFields []map[string]interface{}
+	Fields            map[string]interface{}
+}
+
+var _ json.Unmarshaler = (*JiraCreateTicketAction)(nil)
+var _ json.Marshaler = (*JiraCreateTicketAction)(nil)
+
+type jiraCreateTicketAction struct {
+	Name              *string                  `json:"name,omitempty"`
+	Integration       *Int64OrString           `json:"integration,omitempty"`
+	Project           *string                  `json:"project,omitempty"`
+	IssueType         *string                  `json:"issuetype,omitempty"`
+	DynamicFormFields []map[string]interface{} `json:"dynamic_form_fields,omitempty"`
+}
+
+var jiraCreateTicketActionKeys = []string{"id", "name", "integration", "project", "issuetype", "dynamic_form_fields"}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (a *JiraCreateTicketAction) UnmarshalJSON(data []byte) error {
+	fields, err := decodeIssueAlertRule(data)
+	if err != nil || fields == nil {
+		return err
+	}
+	var v jiraCreateTicketAction
+	if err := unmarshalUseNumber(data, &v); err != nil {
+		return err
+	}
+	for _, key := range jiraCreateTicketActionKeys {
+		delete(fields, key)
+	}
+	if len(fields) == 0 {
+		fields = nil
+	}
+
+	*a = JiraCreateTicketAction{
+		Name:              v.Name,
+		Integration:       v.Integration,
+		Project:           v.Project,
+		IssueType:         v.IssueType,
+		DynamicFormFields: v.DynamicFormFields,
+		Fields:            fields,
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (a JiraCreateTicketAction) MarshalJSON() ([]byte, error) {
+	b, err := json.Marshal(jiraCreateTicketAction{
+		Name:              a.Name,
+		Integration:       a.Integration,
+		Project:           a.Project,
+		IssueType:         a.IssueType,
+		DynamicFormFields: a.DynamicFormFields,
+	})
+	if err != nil || len(a.Fields) == 0 {
+		return b, err
+	}
+
+	m, err := decodeIssueAlertRule(b)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range a.Fields {
+		if _, ok := m[k]; !ok {
+			m[k] = v
+		}
+	}
+	return json.Marshal(m)
+}
+
+// IssueAlertAction is an action of an issue alert. Exactly one field is set:
+// the typed field matching a built-in action, or Unknown, holding the action
+// as sent by Sentry, for any other action. As with IssueAlertCondition, the
+// keys a typed field does not model are kept in its Extra field, or Fields for
+// Jira actions.
+type IssueAlertAction struct {
+	NotifyEmail            *NotifyEmailAction
+	NotifyEvent            *NotifyEventAction
+	NotifyEventService     *NotifyEventServiceAction
+	SlackNotifyService     *SlackNotifyServiceAction
+	PagerDutyNotifyService *PagerDutyNotifyServiceAction
+	OpsgenieNotifyTeam     *OpsgenieNotifyTeamAction
+	MsTeamsNotifyService   *MsTeamsNotifyServiceAction
+	DiscordNotifyService   *DiscordNotifyServiceAction
+	JiraCreateTicket       *JiraCreateTicketAction
+	JiraServerCreateTicket *JiraCreateTicketAction
+	Unknown                map[string]interface{}
+}
+
+var _ json.Unmarshaler = (*IssueAlertAction)(nil)
+var _ json.Marshaler = (*IssueAlertAction)(nil)
+
+func (a IssueAlertAction) rule() (string, interface{}) {
+	switch {
+	case a.NotifyEmail != nil:
+		return IssueAlertActionNotifyEmail, a.NotifyEmail
+	case a.NotifyEvent != nil:
+		return IssueAlertActionNotifyEvent, a.NotifyEvent
+	case a.NotifyEventService != nil:
+		return IssueAlertActionNotifyEventService, a.NotifyEventService
+	case a.SlackNotifyService != nil:
+		return IssueAlertActionSlackNotifyService, a.SlackNotifyService
+	case a.PagerDutyNotifyService != nil:
+		return IssueAlertActionPagerDutyNotifyService, a.PagerDutyNotifyService
+	case a.OpsgenieNotifyTeam != nil:
+		return IssueAlertActionOpsgenieNotifyTeam, a.OpsgenieNotifyTeam
+	case a.MsTeamsNotifyService != nil:
+		return IssueAlertActionMsTeamsNotifyService, a.MsTeamsNotifyService
+	case a.DiscordNotifyService != nil:
+		return IssueAlertActionDiscordNotifyService, a.DiscordNotifyService
+	case a.JiraCreateTicket != nil:
+		return IssueAlertActionJiraCreateTicket, a.JiraCreateTicket
+	case a.JiraServerCreateTicket != nil:
+		return IssueAlertActionJiraServerCreateTicket, a.JiraServerCreateTicket
+	}
+	return issueAlertRuleID(a.Unknown), nil
+}
+
+// ID returns the ID of the action.
+func (a IssueAlertAction) ID() string {
+	id, _ := a.rule()
+	return id
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (a *IssueAlertAction) UnmarshalJSON(data []byte) error {
+	raw, err := decodeIssueAlertRule(data)
+	if err != nil || raw == nil {
+		return err
+	}
+
+	*a = IssueAlertAction{}
+	var v interface{}
+	switch issueAlertRuleID(raw) {
+	case IssueAlertActionNotifyEmail:
+		a.NotifyEmail = new(NotifyEmailAction)
+		v = a.NotifyEmail
+	case IssueAlertActionNotifyEvent:
+		a.NotifyEvent = new(NotifyEventAction)
+		v = a.NotifyEvent
+	case IssueAlertActionNotifyEventService:
+		a.NotifyEventService = new(NotifyEventServiceAction)
+		v = a.NotifyEventService
+	case IssueAlertActionSlackNotifyService:
+		a.SlackNotifyService = new(SlackNotifyServiceAction)
+		v = a.SlackNotifyService
+	case IssueAlertActionPagerDutyNotifyService:
+		a.PagerDutyNotifyService = new(PagerDutyNotifyServiceAction)
+		v = a.PagerDutyNotifyService
+	case IssueAlertActionOpsgenieNotifyTeam:
+		a.OpsgenieNotifyTeam = new(OpsgenieNotifyTeamAction)
+		v = a.OpsgenieNotifyTeam
+	case IssueAlertActionMsTeamsNotifyService:
+		a.MsTeamsNotifyService = new(MsTeamsNotifyServiceAction)
+		v = a.MsTeamsNotifyService
+	case IssueAlertActionDiscordNotifyService:
+		a.DiscordNotifyService = new(DiscordNotifyServiceAction)
+		v = a.DiscordNotifyService
+	case IssueAlertActionJiraCreateTicket:
+		a.JiraCreateTicket = new(JiraCreateTicketAction)
+		v = a.JiraCreateTicket
+	case IssueAlertActionJiraServerCreateTicket:
+		a.JiraServerCreateTicket = new(JiraCreateTicketAction)
+		v = a.JiraServerCreateTicket
+	default:
+		a.Unknown = raw
+		return nil
+	}
+	return unmarshalIssueAlertRule(data, raw, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (a IssueAlertAction) MarshalJSON() ([]byte, error) {
+	id, v := a.rule()
+	if v == nil {
+		return json.Marshal(a.Unknown)
+	}
+	return marshalIssueAlertRule(id, v)
+}
+
+// TypedConditions returns the conditions of the issue alert as typed
+// conditions.
+func (a *IssueAlert) TypedConditions() ([]*IssueAlertCondition, error) {
+	return convertIssueAlertRules[*IssueAlertCondition](a.Conditions)
+}
+
+// SetTypedConditions sets the conditions of the issue alert from typed
+// conditions.
+func (a *IssueAlert) SetTypedConditions(conditions []*IssueAlertCondition) error {
+	raw, err := convertIssueAlertRules[map[string]interface{}](conditions)
+	if err != nil {
+		return err
+	}
+	a.Conditions = raw
+	return nil
+}
+
+// TypedFilters returns the filters of the issue alert as typed filters.
+func (a *IssueAlert) TypedFilters() ([]*IssueAlertFilter, error) {
+	return convertIssueAlertRules[*IssueAlertFilter](a.Filters)
+}
+
+// SetTypedFilters sets the filters of the issue alert from typed filters.
+func (a *IssueAlert) SetTypedFilters(filters []*IssueAlertFilter) error {
+	raw, err := convertIssueAlertRules[map[string]interface{}](filters)
+	if err != nil {
+		return err
+	}
+	a.Filters = raw
+	return nil
+}
+
+// TypedActions returns the actions of the issue alert as typed actions.
+func (a *IssueAlert) TypedActions() ([]*IssueAlertAction, error) {
+	return convertIssueAlertRules[*IssueAlertAction](a.Actions)
+}
+
+// SetTypedActions sets the actions of the issue alert from typed actions.
+func (a *IssueAlert) SetTypedActions(actions []*IssueAlertAction) error {
+	raw, err := convertIssueAlertRules[map[string]interface{}](actions)
+	if err != nil {
+		return err
+	}
+	a.Actions = raw
+	return nil
+}
+
+// convertIssueAlertRules converts conditions, filters or actions between
+// their raw and typed forms through their JSON encoding.
+func convertIssueAlertRules[T any, U any](rules []U) ([]T, error) {
+	if rules == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(rules)
+	if err != nil {
+		return nil, err
+	}
+	var converted []T
+	if err := unmarshalUseNumber(b, &converted); err != nil {
+		return nil, err
+	}
+	return converted, nil
+}
+
+// decodeIssueAlertRule decodes a condition, filter or action into a map,
+// keeping numbers as json.Number so they round-trip unchanged. The map is
+// nil if data is null.
+func decodeIssueAlertRule(data []byte) (map[string]interface{}, error) {
+	var raw map[string]interface{}
+	if err := unmarshalUseNumber(data, &raw); err != nil {
+		return nil, err
+	}
+	return raw, nil
+}
+
+// unmarshalIssueAlertRule decodes data into the typed rule v, keeping the keys
+// of raw that v does not model in its Extra field, if any.
+func unmarshalIssueAlertRule(data []byte, raw map[string]interface{}, v interface{}) error {
+	if err := unmarshalUseNumber(data, v); err != nil {
+		return err
+	}
+
+	extra := reflect.ValueOf(v).Elem().FieldByName("Extra")
+	if !extra.IsValid() {
+		return nil
+	}
+	known := issueAlertRuleKeys(reflect.TypeOf(v).Elem())
+	m := map[string]interface{}{}
+	for k, value := range raw {
+		if !known[k] {
+			m[k] = value
+		}
+	}
+	if len(m) > 0 {
+		extra.Set(reflect.ValueOf(m))
+	}
+	return nil
+}
+
+// issueAlertRuleKeys returns the JSON keys modeled by a typed rule, and "id".
+func issueAlertRuleKeys(t reflect.Type) map[string]bool {
+	keys := map[string]bool{"id": true}
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if name != "" && name != "-" {
+			keys[name] = true
+		}
+	}
+	return keys
+}
+
+// marshalIssueAlertRule encodes the typed rule v with its ID and the keys of
+// its Extra field, if any. Typed fields take precedence over Extra.
+func marshalIssueAlertRule(id string, v interface{}) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	m, err := decodeIssueAlertRule(b)
+	if err != nil {
+		return nil, err
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	if extra := rv.FieldByName("Extra"); extra.IsValid() {
+		for k, value := range extra.Interface().(map[string]interface{}) {
+			if _, ok := m[k]; !ok {
+				m[k] = value
+			}
+		}
+	}
+	m["id"] = id
+	return json.Marshal(m)
+}
+
+func issueAlertRuleID(raw map[string]interface{}) string {
+	id, _ := raw["id"].(string)
+	return id
+}
+
+func unmarshalUseNumber(data []byte, v interface{}) error {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	return d.Decode(v)
+}
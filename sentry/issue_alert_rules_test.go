@@ -0,0 +1,223 @@
+package sentry
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssueAlertCondition_JSON(t *testing.T) {
+	data := `[
+		{
+			"id": "sentry.rules.conditions.event_frequency.EventFrequencyCondition",
+			"name": "The issue is seen more than 100 times in 1h",
+			"comparisonType": "percent",
+			"comparisonInterval": "1w",
+			"value": 100,
+			"interval": "1h"
+		},
+		{
+			"id": "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition",
+			"name": "A new issue is created",
+			"value": 500,
+			"interval": "1h"
+		},
+		{
+			"id": "sentry.rules.conditions.some_new.SomeNewCondition",
+			"name": "Something new happens",
+			"threshold": 1.5,
+			"options": {"nested": [1, "two"]}
+		}
+	]`
+
+	var conditions []*IssueAlertCondition
+	require.NoError(t, json.Unmarshal([]byte(data), &conditions))
+
+	expected := []*IssueAlertCondition{
+		{
+			EventFrequency: &EventFrequencyCondition{
+				Name:               String("The issue is seen more than 100 times in 1h"),
+				ComparisonType:     String("percent"),
+				ComparisonInterval: String("1w"),
+				Value:              JsonNumber(json.Number("100")),
+				Interval:           String("1h"),
+			},
+		},
+		{
+			FirstSeenEvent: &FirstSeenEventCondition{
+				Name: String("A new issue is created"),
+				Extra: map[string]interface{}{
+					"value":    json.Number("500"),
+					"interval": "1h",
+				},
+			},
+		},
+		{
+			Unknown: map[string]interface{}{
+				"id":        "sentry.rules.conditions.some_new.SomeNewCondition",
+				"name":      "Something new happens",
+				"threshold": json.Number("1.5"),
+				"options": map[string]interface{}{
+					"nested": []interface{}{json.Number("1"), "two"},
+				},
+			},
+		},
+	}
+	assert.Equal(t, expected, conditions)
+	assert.Equal(t, IssueAlertConditionEventFrequency, conditions[0].ID())
+	assert.Equal(t, "sentry.rules.conditions.some_new.SomeNewCondition", conditions[2].ID())
+
+	b, err := json.Marshal(conditions)
+	require.NoError(t, err)
+	assert.JSONEq(t, data, string(b))
+}
+
+func TestIssueAlertFilter_JSON(t *testing.T) {
+	data := `[
+		{
+			"id": "sentry.rules.filters.assigned_to.AssignedToFilter",
+			"targetType": "Unassigned",
+			"targetIdentifier": ""
+		},
+		{
+			"id": "sentry.rules.filters.level.LevelFilter",
+			"match": "gte",
+			"level": 40
+		},
+		{
+			"id": "sentry.rules.filters.tagged_event.TaggedEventFilter",
+			"key": "customer",
+			"match": "eq",
+			"value": "acme"
+		}
+	]`
+
+	var filters []*IssueAlertFilter
+	require.NoError(t, json.Unmarshal([]byte(data), &filters))
+
+	expected := []*IssueAlertFilter{
+		{
+			AssignedTo: &AssignedToFilter{
+				TargetType:       String("Unassigned"),
+				TargetIdentifier: &Int64OrString{IsString: true},
+			},
+		},
+		{
+			Level: &LevelFilter{
+				Match: String("gte"),
+				Level: &Int64OrString{IsInt64: true, Int64Val: 40},
+			},
+		},
+		{
+			TaggedEvent: &TaggedEventFilter{
+				Key:   String("customer"),
+				Match: String("eq"),
+				Value: String("acme"),
+			},
+		},
+	}
+	assert.Equal(t, expected, filters)
+
+	b, err := json.Marshal(filters)
+	require.NoError(t, err)
+	assert.JSONEq(t, data, string(b))
+}
+
+func TestIssueAlertAction_JSON(t *testing.T) {
+	data := `[
+		{
+			"id": "sentry.integrations.slack.notify_action.SlackNotifyServiceAction",
+			"workspace": "1234",
+			"channel": "#alerts",
+			"uuid": "0c0e4e4a-3ab4-4d9d-a1b1-1e7a0b8a3c3e"
+		},
+		{
+			"id": "sentry.integrations.pagerduty.notify_action.PagerDutyNotifyServiceAction",
+			"account": 123,
+			"service": 456,
+			"severity": "critical"
+		},
+		{
+			"id": "sentry.integrations.opsgenie.notify_action.OpsgenieNotifyTeamAction",
+			"account": 123,
+			"team": "9438930258-fairy",
+			"priority": "P1"
+		},
+		{
+			"id": "sentry.rules.actions.notify_event_service.NotifyEventServiceAction",
+			"service": "webhooks"
+		},
+		{
+			"id": "sentry.integrations.jira.notify_action.JiraCreateTicketAction",
+			"integration": 321,
+			"project": "10000",
+			"issuetype": "10001",
+			"dynamic_form_fields": [{"name": "project", "type": "select"}],
+			"labels": ["sentry"],
+			"customfield_10020": 5
+		}
+	]`
+
+	var actions []*IssueAlertAction
+	require.NoError(t, json.Unmarshal([]byte(data), &actions))
+
+	expected := []*IssueAlertAction{
+		{
+			SlackNotifyService: &SlackNotifyServiceAction{
+				Workspace: &Int64OrString{IsString: true, StringVal: "1234"},
+				Channel:   String("#alerts"),
+				Extra: map[string]interface{}{
+					"uuid": "0c0e4e4a-3ab4-4d9d-a1b1-1e7a0b8a3c3e",
+				},
+			},
+		},
+		{
+			PagerDutyNotifyService: &PagerDutyNotifyServiceAction{
+				Account:  &Int64OrString{IsInt64: true, Int64Val: 123},
+				Service:  &Int64OrString{IsInt64: true, Int64Val: 456},
+				Severity: String("critical"),
+			},
+		},
+		{
+			OpsgenieNotifyTeam: &OpsgenieNotifyTeamAction{
+				Account:  &Int64OrString{IsInt64: true, Int64Val: 123},
+				Team:     String("9438930258-fairy"),
+				Priority: String("P1"),
+			},
+		},
+		{
+			NotifyEventService: &NotifyEventServiceAction{
+				Service: String("webhooks"),
+			},
+		},
+		{
+			JiraCreateTicket: &JiraCreateTicketAction{
+				Integration: &Int64OrString{IsInt64: true, Int64Val: 321},
+				Project:     String("10000"),
+				IssueType:   String("10001"),
+				DynamicFormFields: []map[string]interface{}{
+					{"name": "project", "type": "select"},
+				},
+				Fields: map[string]interface{}{
+					"labels":            []interface{}{"sentry"},
+					"customfield_10020": json.Number("5"),
+				},
+			},
+		},
+	}
+	assert.Equal(t, expected, actions)
+	assert.Equal(t, IssueAlertActionJiraCreateTicket, actions[4].ID())
+
+	b, err := json.Marshal(actions)
+	require.NoError(t, err)
+	assert.JSONEq(t, data, string(b))
+}
+
+func TestIssueAlertAction_UnmarshalJSON_notObject(t *testing.T) {
+	action := IssueAlertAction{NotifyEvent: &NotifyEventAction{}}
+	assert.NoError(t, json.Unmarshal([]byte(`null`), &action))
+	assert.Equal(t, IssueAlertAction{NotifyEvent: &NotifyEventAction{}}, action)
+	assert.Error(t, json.Unmarshal([]byte(`"sentry.rules.actions.notify_event.NotifyEventAction"`), &action))
+}
@@ -0,0 +1,107 @@
+package sentry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Alert snooze targets.
+const (
+	// AlertSnoozeTargetMe mutes the alert for the current user only.
+	AlertSnoozeTargetMe = "me"
+	// AlertSnoozeTargetEveryone mutes the alert for every member.
+	AlertSnoozeTargetEveryone = "everyone"
+)
+
+// AlertSnooze represents the snooze of an issue or metric alert.
+// https://github.com/getsentry/sentry/blob/24.10.0/src/sentry/api/serializers/models/rule_snooze.py
+type AlertSnooze struct {
+	OwnerID *int `json:"ownerId,omitempty"`
+	// UserID is the ID of the user the alert is snoozed for, or "everyone".
+	UserID *Int64OrString `json:"userId,omitempty"`
+	// Until is nil when the alert is snoozed forever.
+	Until       *time.Time `json:"until,omitempty"`
+	DateAdded   *time.Time `json:"dateAdded,omitempty"`
+	RuleID      *int       `json:"ruleId,omitempty"`
+	AlertRuleID *int       `json:"alertRuleId,omitempty"`
+}
+
+var _ json.Unmarshaler = (*AlertSnooze)(nil)
+
+// UnmarshalJSON implements json.Unmarshaler.
+// Sentry reports "forever" rather than a timestamp for open-ended snoozes.
+func (s *AlertSnooze) UnmarshalJSON(data []byte) error {
+	type alertSnooze AlertSnooze
+	v := struct {
+		*alertSnooze
+		Until json.RawMessage `json:"until,omitempty"`
+	}{alertSnooze: (*alertSnooze)(s)}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	s.Until = nil
+	if len(v.Until) == 0 || string(v.Until) == "null" || string(v.Until) == `"forever"` {
+		return nil
+	}
+	var until time.Time
+	if err := json.Unmarshal(v.Until, &until); err != nil {
+		return err
+	}
+	s.Until = &until
+	return nil
+}
+
+// AlertSnoozeParams are the parameters for snoozing an alert.
+type AlertSnoozeParams struct {
+	// Target is AlertSnoozeTargetMe or AlertSnoozeTargetEveryone.
+	Target *string `json:"target,omitempty"`
+	// Until is when the snooze ends. The alert is snoozed forever if nil.
+	Until *time.Time `json:"until,omitempty"`
+}
+
+// Snooze mutes the notifications of an issue alert, for the current user or
+// for everyone.
+func (s *IssueAlertsService) Snooze(ctx context.Context, organizationSlug string, projectSlug string, issueAlertID string, params *AlertSnoozeParams) (*AlertSnooze, *Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/rules/%v/snooze/", organizationSlug, projectSlug, issueAlertID)
+	req, err := s.client.NewRequest("POST", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	snooze := new(AlertSnooze)
+	resp, err := s.client.Do(ctx, req, snooze)
+	if err != nil {
+		return nil, resp, err
+	}
+	return snooze, resp, nil
+}
+
+// Unsnooze removes the snooze of an issue alert for the given target.
+func (s *IssueAlertsService) Unsnooze(ctx context.Context, organizationSlug string, projectSlug string, issueAlertID string, target string) (*Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/rules/%v/snooze/", organizationSlug, projectSlug, issueAlertID)
+	params := &AlertSnoozeParams{Target: &target}
+	req, err := s.client.NewRequest("DELETE", u, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// Enable an issue alert that Sentry disabled for inactivity.
+//
+// There is no Disable counterpart: Sentry only disables rules itself, and
+// the rule endpoints ignore the status field on update. Use Snooze with
+// AlertSnoozeTargetEveryone to stop a rule from notifying, or Delete it.
+func (s *IssueAlertsService) Enable(ctx context.Context, organizationSlug string, projectSlug string, issueAlertID string) (*Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/rules/%v/enable/", organizationSlug, projectSlug, issueAlertID)
+	req, err := s.client.NewRequest("PUT", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
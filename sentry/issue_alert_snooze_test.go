@@ -0,0 +1,147 @@
+package sentry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssueAlertsService_Snooze(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/rules/12345/snooze/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assertPostJSON(t, map[string]interface{}{
+			"target": "me",
+			"until":  "2024-10-18T12:00:00Z",
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{
+			"ownerId": 1,
+			"userId": 2,
+			"until": "2024-10-18T12:00:00Z",
+			"dateAdded": "2024-10-17T12:00:00Z",
+			"ruleId": 12345,
+			"alertRuleId": null
+		}`)
+	})
+
+	ctx := context.Background()
+	snooze, _, err := client.IssueAlerts.Snooze(ctx, "the-interstellar-jurisdiction", "pump-station", "12345", &AlertSnoozeParams{
+		Target: String(AlertSnoozeTargetMe),
+		Until:  Time(time.Date(2024, 10, 18, 12, 0, 0, 0, time.UTC)),
+	})
+	require.NoError(t, err)
+
+	expected := &AlertSnooze{
+		OwnerID:   Int(1),
+		UserID:    &Int64OrString{IsInt64: true, Int64Val: 2},
+		Until:     Time(mustParseTime("2024-10-18T12:00:00Z")),
+		DateAdded: Time(mustParseTime("2024-10-17T12:00:00Z")),
+		RuleID:    Int(12345),
+	}
+	assert.Equal(t, expected, snooze)
+}
+
+func TestIssueAlertsService_Snooze_forever(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/rules/12345/snooze/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assertPostJSON(t, map[string]interface{}{
+			"target": "everyone",
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{
+			"ownerId": 1,
+			"userId": "everyone",
+			"until": "forever",
+			"dateAdded": "2024-10-17T12:00:00Z",
+			"ruleId": 12345,
+			"alertRuleId": null
+		}`)
+	})
+
+	ctx := context.Background()
+	snooze, _, err := client.IssueAlerts.Snooze(ctx, "the-interstellar-jurisdiction", "pump-station", "12345", &AlertSnoozeParams{
+		Target: String(AlertSnoozeTargetEveryone),
+	})
+	require.NoError(t, err)
+
+	expected := &AlertSnooze{
+		OwnerID:   Int(1),
+		UserID:    &Int64OrString{IsString: true, StringVal: "everyone"},
+		DateAdded: Time(mustParseTime("2024-10-17T12:00:00Z")),
+		RuleID:    Int(12345),
+	}
+	assert.Equal(t, expected, snooze)
+}
+
+func TestIssueAlertsService_Unsnooze(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/rules/12345/snooze/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		assertPostJSON(t, map[string]interface{}{
+			"target": "everyone",
+		}, r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.IssueAlerts.Unsnooze(ctx, "the-interstellar-jurisdiction", "pump-station", "12345", AlertSnoozeTargetEveryone)
+	require.NoError(t, err)
+}
+
+func TestIssueAlertsService_Enable(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/rules/12345/enable/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	ctx := context.Background()
+	_, err := client.IssueAlerts.Enable(ctx, "the-interstellar-jurisdiction", "pump-station", "12345")
+	require.NoError(t, err)
+}
+
+func TestIssueAlert_status(t *testing.T) {
+	data := `{
+		"id": "12345",
+		"name": "Notify errors",
+		"status": "disabled",
+		"disableReason": "noisy",
+		"disableDate": "2024-10-24T12:00:00Z",
+		"snooze": true,
+		"snoozeCreatedBy": "You",
+		"snoozeForEveryone": false
+	}`
+
+	var alert IssueAlert
+	require.NoError(t, json.Unmarshal([]byte(data), &alert))
+
+	expected := IssueAlert{
+		ID:                String("12345"),
+		Name:              String("Notify errors"),
+		Status:            String(IssueAlertStatusDisabled),
+		DisableReason:     String("noisy"),
+		DisableDate:       Time(mustParseTime("2024-10-24T12:00:00Z")),
+		Snooze:            Bool(true),
+		SnoozeCreatedBy:   String("You"),
+		SnoozeForEveryone: Bool(false),
+	}
+	assert.Equal(t, expected, alert)
+}
@@ -25,8 +25,27 @@ type IssueAlert struct {
 	Environment *string                  `json:"environment,omitempty"`
 	Projects    []string                 `json:"projects,omitempty"`
 	TaskUUID    *string                  `json:"uuid,omitempty"` // This is actually the UUID of the async task that can be spawned to create the rule
+
+	// Status is "active", or "disabled" once Sentry disabled the rule for
+	// inactivity. It is read-only: disabled rules are enabled again with
+	// Enable.
+	Status        *string    `json:"status,omitempty"`
+	DisableReason *string    `json:"disableReason,omitempty"`
+	DisableDate   *time.Time `json:"disableDate,omitempty"`
+
+	// Snooze is whether the rule is snoozed for the current user, either
+	// for them only or for everyone.
+	Snooze            *bool   `json:"snooze,omitempty"`
+	SnoozeCreatedBy   *string `json:"snoozeCreatedBy,omitempty"`
+	SnoozeForEveryone *bool   `json:"snoozeForEveryone,omitempty"`
 }
 
+// Issue alert statuses.
+const (
+	IssueAlertStatusActive   = "active"
+	IssueAlertStatusDisabled = "disabled"
+)
+
 // IssueAlertCreatedBy for defining the rule creator.
 type IssueAlertCreatedBy struct {
 	ID    *int    `json:"id,omitempty"`
@@ -68,6 +87,13 @@ func (s *IssueAlertsService) List(ctx context.Context, organizationSlug string,
 	return alerts, resp, nil
 }
 
+// ListAll returns all issue alerts configured for a project, following pagination.
+func (s *IssueAlertsService) ListAll(ctx context.Context, organizationSlug string, projectSlug string) ([]*IssueAlert, *Response, error) {
+	return NewPager(func(ctx context.Context, cursor string) ([]*IssueAlert, *Response, error) {
+		return s.List(ctx, organizationSlug, projectSlug, &ListCursorParams{Cursor: cursor})
+	}).All(ctx)
+}
+
 // Get details on an issue alert.
 func (s *IssueAlertsService) Get(ctx context.Context, organizationSlug string, projectSlug string, id string) (*IssueAlert, *Response, error) {
 	u := fmt.Sprintf("0/projects/%v/%v/rules/%v/", organizationSlug, projectSlug, id)
@@ -109,43 +135,25 @@ func (s *IssueAlertsService) Create(ctx context.Context, organizationSlug string
 	return alert, resp, nil
 }
 
-// getIssueAlertFromTaskDetail is called when Sentry offloads the issue alert creation process to an async task and sends us back the task's uuid.
+// getIssueAlertFromTaskDetail is called when Sentry offloads the issue alert creation or update process to an async task and sends us back the task's uuid.
 // It usually doesn't happen, but when creating Slack notification rules, it seemed to be sometimes the case. During testing it
 // took very long for a task to finish (10+ seconds) which is why this method can take long to return.
+// The task is polled according to the client's AsyncTaskPolicy.
 func (s *IssueAlertsService) getIssueAlertFromTaskDetail(ctx context.Context, organizationSlug string, projectSlug string, taskUUID string) (*IssueAlert, *Response, error) {
 	u := fmt.Sprintf("0/projects/%v/%v/rule-task/%v/", organizationSlug, projectSlug, taskUUID)
-	req, err := s.client.NewRequest("GET", u, nil)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	var resp *Response
-	for i := 0; i < 5; i++ {
-		// TODO: Read poll interval from context
-		time.Sleep(5 * time.Second)
+	return pollAsyncTask(ctx, s.client.AsyncTaskPolicy, taskUUID, func(ctx context.Context) (*string, *IssueAlert, *string, *Response, error) {
+		req, err := s.client.NewRequest("GET", u, nil)
+		if err != nil {
+			return nil, nil, nil, nil, err
+		}
 
 		taskDetail := new(IssueAlertTaskDetail)
 		resp, err := s.client.Do(ctx, req, taskDetail)
 		if err != nil {
-			return nil, resp, err
+			return nil, nil, nil, resp, err
 		}
-
-		if resp.StatusCode == 404 {
-			return nil, resp, fmt.Errorf("cannot find issue alert creation task with UUID %v", taskUUID)
-		}
-		if taskDetail.Status != nil && taskDetail.Rule != nil {
-			if *taskDetail.Status == "success" {
-				return taskDetail.Rule, resp, err
-			} else if *taskDetail.Status == "failed" {
-				if taskDetail != nil {
-					return taskDetail.Rule, resp, errors.New(*taskDetail.Error)
-				}
-
-				return taskDetail.Rule, resp, errors.New("error while running the issue alert creation task")
-			}
-		}
-	}
-	return nil, resp, errors.New("getting the status of the issue alert creation from Sentry took too long")
+		return taskDetail.Status, taskDetail.Rule, taskDetail.Error, resp, nil
+	})
 }
 
 // Update an issue alert.
@@ -161,6 +169,15 @@ func (s *IssueAlertsService) Update(ctx context.Context, organizationSlug string
 	if err != nil {
 		return nil, resp, err
 	}
+
+	if resp.StatusCode == 202 {
+		if alert.TaskUUID == nil {
+			return nil, resp, errors.New("missing task uuid")
+		}
+		// We just received a reference to an async task, we need to check another endpoint to retrieve the issue alert we updated
+		return s.getIssueAlertFromTaskDetail(ctx, organizationSlug, projectSlug, *alert.TaskUUID)
+	}
+
 	return alert, resp, nil
 }
 
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -84,6 +85,96 @@ func TestIssueAlertsService_List(t *testing.T) {
 
 }
 
+func TestIssueAlertsService_List_typed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/rules/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[
+			{
+				"id": "12345",
+				"name": "Notify errors",
+				"conditions": [
+					{
+						"id": "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition",
+						"name": "An issue is first seen",
+						"value": 500,
+						"interval": "1h"
+					}
+				],
+				"filters": [
+					{
+						"id": "sentry.rules.filters.level.LevelFilter",
+						"match": "gte",
+						"level": "40"
+					}
+				],
+				"actions": [
+					{
+						"id": "sentry.integrations.slack.notify_action.SlackNotifyServiceAction",
+						"workspace": "1234",
+						"channel": "#dummy-channel",
+						"uuid": "0c0e4e4a-3ab4-4d9d-a1b1-1e7a0b8a3c3e"
+					}
+				]
+			}
+		]`)
+	})
+
+	ctx := context.Background()
+	alerts, _, err := client.IssueAlerts.List(ctx, "the-interstellar-jurisdiction", "pump-station", nil)
+	require.NoError(t, err)
+	require.Len(t, alerts, 1)
+
+	conditions, err := alerts[0].TypedConditions()
+	require.NoError(t, err)
+	assert.Equal(t, []*IssueAlertCondition{
+		{
+			FirstSeenEvent: &FirstSeenEventCondition{
+				Name: String("An issue is first seen"),
+				Extra: map[string]interface{}{
+					"value":    json.Number("500"),
+					"interval": "1h",
+				},
+			},
+		},
+	}, conditions)
+
+	filters, err := alerts[0].TypedFilters()
+	require.NoError(t, err)
+	assert.Equal(t, []*IssueAlertFilter{
+		{
+			Level: &LevelFilter{
+				Match: String("gte"),
+				Level: &Int64OrString{IsString: true, StringVal: "40"},
+			},
+		},
+	}, filters)
+
+	actions, err := alerts[0].TypedActions()
+	require.NoError(t, err)
+	assert.Equal(t, []*IssueAlertAction{
+		{
+			SlackNotifyService: &SlackNotifyServiceAction{
+				Workspace: &Int64OrString{IsString: true, StringVal: "1234"},
+				Channel:   String("#dummy-channel"),
+				Extra: map[string]interface{}{
+					"uuid": "0c0e4e4a-3ab4-4d9d-a1b1-1e7a0b8a3c3e",
+				},
+			},
+		},
+	}, actions)
+
+	// Setting the typed rules back leaves the alert unchanged.
+	alert := *alerts[0]
+	require.NoError(t, alert.SetTypedConditions(conditions))
+	require.NoError(t, alert.SetTypedFilters(filters))
+	require.NoError(t, alert.SetTypedActions(actions))
+	assert.Equal(t, alerts[0], &alert)
+}
+
 func TestIssueAlertsService_Get(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -459,9 +550,66 @@ func TestIssueAlertsService_Create(t *testing.T) {
 
 }
 
+func TestIssueAlertsService_Create_typed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/rules/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assertPostJSONValue(t, map[string]interface{}{
+			"name": "Notify errors",
+			"conditions": []interface{}{
+				map[string]interface{}{
+					"id":       "sentry.rules.conditions.event_frequency.EventFrequencyCondition",
+					"value":    json.Number("10"),
+					"interval": "1h",
+				},
+			},
+			"actions": []interface{}{
+				map[string]interface{}{
+					"id":        "sentry.integrations.slack.notify_action.SlackNotifyServiceAction",
+					"workspace": "1234",
+					"channel":   "#dummy-channel",
+				},
+			},
+		}, r)
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id": "123456", "name": "Notify errors"}`)
+	})
+
+	params := &IssueAlert{Name: String("Notify errors")}
+	require.NoError(t, params.SetTypedConditions([]*IssueAlertCondition{
+		{
+			EventFrequency: &EventFrequencyCondition{
+				Value:    JsonNumber(json.Number("10")),
+				Interval: String("1h"),
+			},
+		},
+	}))
+	require.NoError(t, params.SetTypedActions([]*IssueAlertAction{
+		{
+			SlackNotifyService: &SlackNotifyServiceAction{
+				Workspace: &Int64OrString{IsString: true, StringVal: "1234"},
+				Channel:   String("#dummy-channel"),
+			},
+		},
+	}))
+	ctx := context.Background()
+	alert, _, err := client.IssueAlerts.Create(ctx, "the-interstellar-jurisdiction", "pump-station", params)
+	require.NoError(t, err)
+
+	expected := &IssueAlert{
+		ID:   String("123456"),
+		Name: String("Notify errors"),
+	}
+	assert.Equal(t, expected, alert)
+}
+
 func TestIssueAlertsService_CreateWithAsyncTask(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
+	client.AsyncTaskPolicy = &AsyncTaskPolicy{Interval: time.Millisecond}
 
 	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/rule-task/fakeuuid/", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
@@ -745,3 +893,38 @@ func TestIssueAlertsService_Delete(t *testing.T) {
 	_, err := client.IssueAlerts.Delete(ctx, "the-interstellar-jurisdiction", "pump-station", "12345")
 	require.NoError(t, err)
 }
+
+func TestIssueAlertsService_UpdateWithAsyncTask(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.AsyncTaskPolicy = &AsyncTaskPolicy{Interval: time.Millisecond}
+
+	polls := 0
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/rule-task/fakeuuid/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		polls++
+		w.Header().Set("Content-Type", "application/json")
+		if polls < 3 {
+			fmt.Fprint(w, `{"status": "pending", "error": null, "rule": null}`)
+			return
+		}
+		fmt.Fprint(w, `{"status": "success", "error": null, "rule": {"id": "12345", "name": "Notify errors"}}`)
+	})
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/rules/12345/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"uuid": "fakeuuid"}`)
+	})
+
+	ctx := context.Background()
+	alert, _, err := client.IssueAlerts.Update(ctx, "the-interstellar-jurisdiction", "pump-station", "12345", &IssueAlert{Name: String("Notify errors")})
+	require.NoError(t, err)
+
+	expected := &IssueAlert{
+		ID:   String("12345"),
+		Name: String("Notify errors"),
+	}
+	assert.Equal(t, expected, alert)
+	assert.Equal(t, 3, polls)
+}
@@ -0,0 +1,148 @@
+package sentry
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// IssueSearchTypeIssue is the search type of issue searches.
+const IssueSearchTypeIssue = 0
+
+// Issue search visibilities.
+const (
+	// IssueSearchVisibilityOwner searches are only visible to their creator.
+	IssueSearchVisibilityOwner = "owner"
+	// IssueSearchVisibilityOwnerPinned searches are the pinned search of their creator.
+	IssueSearchVisibilityOwnerPinned = "owner_pinned"
+	// IssueSearchVisibilityOrganization searches are visible to the whole organization.
+	IssueSearchVisibilityOrganization = "organization"
+)
+
+// IssueSearch represents a saved issue search.
+// https://github.com/getsentry/sentry/blob/24.10.0/src/sentry/api/serializers/models/savedsearch.py
+type IssueSearch struct {
+	ID          *string    `json:"id,omitempty"`
+	Type        *int       `json:"type,omitempty"`
+	Name        *string    `json:"name,omitempty"`
+	Query       *string    `json:"query,omitempty"`
+	Sort        *string    `json:"sort,omitempty"`
+	Visibility  *string    `json:"visibility,omitempty"`
+	DateCreated *time.Time `json:"dateCreated,omitempty"`
+	IsGlobal    *bool      `json:"isGlobal,omitempty"`
+	IsPinned    *bool      `json:"isPinned,omitempty"`
+}
+
+// IssueSearchesService provides methods for accessing Sentry saved issue search API endpoints.
+type IssueSearchesService service
+
+// List the saved issue searches of an organization visible to the current user,
+// including global searches and the user's pinned search.
+func (s *IssueSearchesService) List(ctx context.Context, organizationSlug string) ([]*IssueSearch, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/searches/", organizationSlug)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	searches := []*IssueSearch{}
+	resp, err := s.client.Do(ctx, req, &searches)
+	if err != nil {
+		return nil, resp, err
+	}
+	return searches, resp, nil
+}
+
+// IssueSearchParams are the parameters for IssueSearchesService.Create and
+// IssueSearchesService.Update.
+type IssueSearchParams struct {
+	Type  *int    `json:"type,omitempty"`
+	Name  *string `json:"name,omitempty"`
+	Query *string `json:"query,omitempty"`
+	// One of "date", "new", "freq", "user", "trends" or "inbox".
+	Sort *string `json:"sort,omitempty"`
+	// IssueSearchVisibilityOwner or IssueSearchVisibilityOrganization.
+	Visibility *string `json:"visibility,omitempty"`
+}
+
+// Create a saved issue search.
+func (s *IssueSearchesService) Create(ctx context.Context, organizationSlug string, params *IssueSearchParams) (*IssueSearch, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/searches/", organizationSlug)
+	req, err := s.client.NewRequest("POST", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	search := new(IssueSearch)
+	resp, err := s.client.Do(ctx, req, search)
+	if err != nil {
+		return nil, resp, err
+	}
+	return search, resp, nil
+}
+
+// Update a saved issue search.
+func (s *IssueSearchesService) Update(ctx context.Context, organizationSlug string, id string, params *IssueSearchParams) (*IssueSearch, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/searches/%v/", organizationSlug, id)
+	req, err := s.client.NewRequest("PUT", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	search := new(IssueSearch)
+	resp, err := s.client.Do(ctx, req, search)
+	if err != nil {
+		return nil, resp, err
+	}
+	return search, resp, nil
+}
+
+// Delete a saved issue search.
+func (s *IssueSearchesService) Delete(ctx context.Context, organizationSlug string, id string) (*Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/searches/%v/", organizationSlug, id)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+type pinnedIssueSearchParams struct {
+	Type  int     `json:"type"`
+	Query *string `json:"query,omitempty"`
+	Sort  *string `json:"sort,omitempty"`
+}
+
+// Pin an issue search for the current user, replacing any previously pinned search.
+func (s *IssueSearchesService) Pin(ctx context.Context, organizationSlug string, query string, sort *string) (*IssueSearch, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/pinned-searches/", organizationSlug)
+	params := &pinnedIssueSearchParams{
+		Type:  IssueSearchTypeIssue,
+		Query: String(query),
+		Sort:  sort,
+	}
+	req, err := s.client.NewRequest("PUT", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	search := new(IssueSearch)
+	resp, err := s.client.Do(ctx, req, search)
+	if err != nil {
+		return nil, resp, err
+	}
+	return search, resp, nil
+}
+
+// Unpin the pinned issue search of the current user.
+func (s *IssueSearchesService) Unpin(ctx context.Context, organizationSlug string) (*Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/pinned-searches/", organizationSlug)
+	params := &pinnedIssueSearchParams{Type: IssueSearchTypeIssue}
+	req, err := s.client.NewRequest("DELETE", u, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
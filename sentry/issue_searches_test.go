@@ -0,0 +1,202 @@
+package sentry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssueSearchesService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/searches/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[
+			{
+				"id": "1",
+				"type": 0,
+				"name": "Unresolved Issues",
+				"query": "is:unresolved",
+				"sort": "date",
+				"visibility": "organization",
+				"dateCreated": null,
+				"isGlobal": true,
+				"isPinned": false
+			},
+			{
+				"id": "42",
+				"type": 0,
+				"name": "Payments",
+				"query": "is:unresolved team:#payments",
+				"sort": "freq",
+				"visibility": "owner_pinned",
+				"dateCreated": "2024-01-01T00:00:00Z",
+				"isGlobal": false,
+				"isPinned": true
+			}
+		]`)
+	})
+
+	ctx := context.Background()
+	searches, _, err := client.IssueSearches.List(ctx, "the-interstellar-jurisdiction")
+	assert.NoError(t, err)
+
+	expected := []*IssueSearch{
+		{
+			ID:         String("1"),
+			Type:       Int(IssueSearchTypeIssue),
+			Name:       String("Unresolved Issues"),
+			Query:      String("is:unresolved"),
+			Sort:       String("date"),
+			Visibility: String(IssueSearchVisibilityOrganization),
+			IsGlobal:   Bool(true),
+			IsPinned:   Bool(false),
+		},
+		{
+			ID:          String("42"),
+			Type:        Int(IssueSearchTypeIssue),
+			Name:        String("Payments"),
+			Query:       String("is:unresolved team:#payments"),
+			Sort:        String("freq"),
+			Visibility:  String(IssueSearchVisibilityOwnerPinned),
+			DateCreated: Time(mustParseTime("2024-01-01T00:00:00Z")),
+			IsGlobal:    Bool(false),
+			IsPinned:    Bool(true),
+		},
+	}
+	assert.Equal(t, expected, searches)
+}
+
+func TestIssueSearchesService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/searches/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assertPostJSON(t, map[string]interface{}{
+			"type":       json.Number("0"),
+			"name":       "Payments",
+			"query":      "is:unresolved team:#payments",
+			"sort":       "freq",
+			"visibility": "organization",
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": "43", "type": 0, "name": "Payments", "visibility": "organization"}`)
+	})
+
+	ctx := context.Background()
+	search, _, err := client.IssueSearches.Create(ctx, "the-interstellar-jurisdiction", &IssueSearchParams{
+		Type:       Int(IssueSearchTypeIssue),
+		Name:       String("Payments"),
+		Query:      String("is:unresolved team:#payments"),
+		Sort:       String("freq"),
+		Visibility: String(IssueSearchVisibilityOrganization),
+	})
+	assert.NoError(t, err)
+
+	expected := &IssueSearch{
+		ID:         String("43"),
+		Type:       Int(IssueSearchTypeIssue),
+		Name:       String("Payments"),
+		Visibility: String(IssueSearchVisibilityOrganization),
+	}
+	assert.Equal(t, expected, search)
+}
+
+func TestIssueSearchesService_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/searches/43/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		assertPostJSON(t, map[string]interface{}{
+			"name":       "Payments (owner)",
+			"visibility": "owner",
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id": "43", "name": "Payments (owner)", "visibility": "owner"}`)
+	})
+
+	ctx := context.Background()
+	search, _, err := client.IssueSearches.Update(ctx, "the-interstellar-jurisdiction", "43", &IssueSearchParams{
+		Name:       String("Payments (owner)"),
+		Visibility: String(IssueSearchVisibilityOwner),
+	})
+	assert.NoError(t, err)
+
+	expected := &IssueSearch{
+		ID:         String("43"),
+		Name:       String("Payments (owner)"),
+		Visibility: String(IssueSearchVisibilityOwner),
+	}
+	assert.Equal(t, expected, search)
+}
+
+func TestIssueSearchesService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/searches/43/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.IssueSearches.Delete(ctx, "the-interstellar-jurisdiction", "43")
+	assert.NoError(t, err)
+}
+
+func TestIssueSearchesService_Pin(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/pinned-searches/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		assertPostJSON(t, map[string]interface{}{
+			"type":  json.Number("0"),
+			"query": "is:unresolved assigned:me",
+			"sort":  "date",
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": "44", "type": 0, "query": "is:unresolved assigned:me", "sort": "date", "visibility": "owner_pinned", "isPinned": true}`)
+	})
+
+	ctx := context.Background()
+	search, _, err := client.IssueSearches.Pin(ctx, "the-interstellar-jurisdiction", "is:unresolved assigned:me", String("date"))
+	assert.NoError(t, err)
+
+	expected := &IssueSearch{
+		ID:         String("44"),
+		Type:       Int(IssueSearchTypeIssue),
+		Query:      String("is:unresolved assigned:me"),
+		Sort:       String("date"),
+		Visibility: String(IssueSearchVisibilityOwnerPinned),
+		IsPinned:   Bool(true),
+	}
+	assert.Equal(t, expected, search)
+}
+
+func TestIssueSearchesService_Unpin(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/pinned-searches/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		assertPostJSON(t, map[string]interface{}{
+			"type": json.Number("0"),
+		}, r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.IssueSearches.Unpin(ctx, "the-interstellar-jurisdiction")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,347 @@
+package sentry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	IssueStatusResolved              string = "resolved"
+	IssueStatusResolvedInNextRelease string = "resolvedInNextRelease"
+	IssueStatusUnresolved            string = "unresolved"
+	IssueStatusIgnored               string = "ignored"
+
+	IssueSubstatusArchivedUntilEscalating string = "archived_until_escalating"
+	IssueSubstatusArchivedUntilCondition  string = "archived_until_condition_met"
+	IssueSubstatusArchivedForever         string = "archived_forever"
+)
+
+// IssueProject represents the project an issue belongs to.
+type IssueProject struct {
+	ID       *string `json:"id,omitempty"`
+	Name     *string `json:"name,omitempty"`
+	Slug     *string `json:"slug,omitempty"`
+	Platform *string `json:"platform,omitempty"`
+}
+
+// IssueAssignee represents the user or team an issue is assigned to.
+type IssueAssignee struct {
+	Type  *string `json:"type,omitempty"`
+	ID    *string `json:"id,omitempty"`
+	Name  *string `json:"name,omitempty"`
+	Email *string `json:"email,omitempty"`
+}
+
+// IssueStatusDetails holds the conditions of a resolution or an ignore.
+// https://github.com/getsentry/sentry/blob/24.10.0/src/sentry/api/helpers/group_index/validators/status_details.py
+type IssueStatusDetails struct {
+	// Resolution
+	InRelease     *string         `json:"inRelease,omitempty"`
+	InNextRelease *bool           `json:"inNextRelease,omitempty"`
+	InCommit      json.RawMessage `json:"inCommit,omitempty"`
+	AutoResolved  *bool           `json:"autoResolved,omitempty"`
+
+	// Ignore (snooze) conditions
+	IgnoreDuration        *int       `json:"ignoreDuration,omitempty"` // In minutes.
+	IgnoreCount           *int       `json:"ignoreCount,omitempty"`
+	IgnoreWindow          *int       `json:"ignoreWindow,omitempty"` // In minutes.
+	IgnoreUserCount       *int       `json:"ignoreUserCount,omitempty"`
+	IgnoreUserWindow      *int       `json:"ignoreUserWindow,omitempty"` // In minutes.
+	IgnoreUntil           *time.Time `json:"ignoreUntil,omitempty"`
+	IgnoreUntilEscalating *bool      `json:"ignoreUntilEscalating,omitempty"`
+}
+
+// Issue represents a Sentry issue, also known as a group.
+// https://github.com/getsentry/sentry/blob/24.10.0/src/sentry/api/serializers/models/group.py
+type Issue struct {
+	ID                  *string                 `json:"id,omitempty"`
+	ShareID             *string                 `json:"shareId,omitempty"`
+	ShortID             *string                 `json:"shortId,omitempty"`
+	Title               *string                 `json:"title,omitempty"`
+	Culprit             *string                 `json:"culprit,omitempty"`
+	Permalink           *string                 `json:"permalink,omitempty"`
+	Logger              *string                 `json:"logger,omitempty"`
+	Level               *string                 `json:"level,omitempty"`
+	Status              *string                 `json:"status,omitempty"`
+	StatusDetails       *IssueStatusDetails     `json:"statusDetails,omitempty"`
+	Substatus           *string                 `json:"substatus,omitempty"`
+	IsPublic            *bool                   `json:"isPublic,omitempty"`
+	Platform            *string                 `json:"platform,omitempty"`
+	Project             *IssueProject           `json:"project,omitempty"`
+	Type                *string                 `json:"type,omitempty"`
+	Metadata            map[string]interface{}  `json:"metadata,omitempty"`
+	NumComments         *int                    `json:"numComments,omitempty"`
+	AssignedTo          *IssueAssignee          `json:"assignedTo,omitempty"`
+	IsBookmarked        *bool                   `json:"isBookmarked,omitempty"`
+	IsSubscribed        *bool                   `json:"isSubscribed,omitempty"`
+	SubscriptionDetails map[string]interface{}  `json:"subscriptionDetails,omitempty"`
+	HasSeen             *bool                   `json:"hasSeen,omitempty"`
+	IssueType           *string                 `json:"issueType,omitempty"`
+	IssueCategory       *string                 `json:"issueCategory,omitempty"`
+	Priority            *string                 `json:"priority,omitempty"`
+	IsUnhandled         *bool                   `json:"isUnhandled,omitempty"`
+	Count               *string                 `json:"count,omitempty"`
+	UserCount           *int                    `json:"userCount,omitempty"`
+	FirstSeen           *time.Time              `json:"firstSeen,omitempty"`
+	LastSeen            *time.Time              `json:"lastSeen,omitempty"`
+	Stats               map[string][][2]float64 `json:"stats,omitempty"`
+	UserReportCount     *int                    `json:"userReportCount,omitempty"`
+	// TODO: annotations
+	// TODO: activity
+	// TODO: seenBy
+	// TODO: firstRelease
+	// TODO: lastRelease
+}
+
+// IssuesService provides methods for accessing Sentry issue API endpoints.
+// https://docs.sentry.io/api/events/
+type IssuesService service
+
+// ListIssuesParams are the parameters for IssuesService.List.
+type ListIssuesParams struct {
+	ListCursorParams
+
+	// An issue search query, e.g. "is:unresolved".
+	Query *string `url:"query,omitempty"`
+	// The period of time for the query, e.g. "24h" or "14d".
+	StatsPeriod *string    `url:"statsPeriod,omitempty"`
+	Start       *time.Time `url:"start,omitempty"`
+	End         *time.Time `url:"end,omitempty"`
+	Environment []string   `url:"environment,omitempty"`
+	// The IDs of the projects to filter by.
+	Project []int `url:"project,omitempty"`
+	// One of "date", "new", "freq", "user", "trends" or "inbox".
+	Sort          *string  `url:"sort,omitempty"`
+	Limit         *int     `url:"limit,omitempty"`
+	ShortIDLookup *bool    `url:"shortIdLookup,omitempty"`
+	Expand        []string `url:"expand,omitempty"`
+	Collapse      []string `url:"collapse,omitempty"`
+}
+
+// List issues of an organization.
+// https://docs.sentry.io/api/events/list-an-organizations-issues/
+func (s *IssuesService) List(ctx context.Context, organizationSlug string, params *ListIssuesParams) ([]*Issue, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/issues/", organizationSlug)
+	u, err := addQuery(u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issues := []*Issue{}
+	resp, err := s.client.Do(ctx, req, &issues)
+	if err != nil {
+		return nil, resp, err
+	}
+	return issues, resp, nil
+}
+
+// ListAll returns all issues of an organization matching params, following pagination.
+func (s *IssuesService) ListAll(ctx context.Context, organizationSlug string, params *ListIssuesParams) ([]*Issue, *Response, error) {
+	p := ListIssuesParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewPager(func(ctx context.Context, cursor string) ([]*Issue, *Response, error) {
+		p.Cursor = cursor
+		return s.List(ctx, organizationSlug, &p)
+	}).All(ctx)
+}
+
+// ListProjectIssuesParams are the parameters for IssuesService.ListByProject.
+type ListProjectIssuesParams struct {
+	ListCursorParams
+
+	// An issue search query, e.g. "is:unresolved".
+	Query *string `url:"query,omitempty"`
+	// The period of time for the stats, either "24h" or "14d".
+	StatsPeriod   *string  `url:"statsPeriod,omitempty"`
+	Environment   []string `url:"environment,omitempty"`
+	ShortIDLookup *bool    `url:"shortIdLookup,omitempty"`
+	// A list of hashes of groups to return.
+	Hashes []string `url:"hashes,omitempty"`
+}
+
+// ListByProject lists issues of a project.
+// https://docs.sentry.io/api/events/list-a-projects-issues/
+func (s *IssuesService) ListByProject(ctx context.Context, organizationSlug string, projectSlug string, params *ListProjectIssuesParams) ([]*Issue, *Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/issues/", organizationSlug, projectSlug)
+	u, err := addQuery(u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issues := []*Issue{}
+	resp, err := s.client.Do(ctx, req, &issues)
+	if err != nil {
+		return nil, resp, err
+	}
+	return issues, resp, nil
+}
+
+// Get details on an issue.
+// https://docs.sentry.io/api/events/retrieve-an-issue/
+func (s *IssuesService) Get(ctx context.Context, organizationSlug string, issueID string) (*Issue, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/issues/%v/", organizationSlug, issueID)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issue := new(Issue)
+	resp, err := s.client.Do(ctx, req, issue)
+	if err != nil {
+		return nil, resp, err
+	}
+	return issue, resp, nil
+}
+
+// UpdateIssueParams are the parameters for IssuesService.Update and IssuesService.BulkUpdate.
+type UpdateIssueParams struct {
+	Status        *string             `json:"status,omitempty"`
+	StatusDetails *IssueStatusDetails `json:"statusDetails,omitempty"`
+	Substatus     *string             `json:"substatus,omitempty"`
+	// The actor to assign the issues to, e.g. "user:1" or "team:2".
+	// Set to an empty string to unassign.
+	AssignedTo   *string `json:"assignedTo,omitempty"`
+	HasSeen      *bool   `json:"hasSeen,omitempty"`
+	IsBookmarked *bool   `json:"isBookmarked,omitempty"`
+	IsSubscribed *bool   `json:"isSubscribed,omitempty"`
+	IsPublic     *bool   `json:"isPublic,omitempty"`
+	Priority     *string `json:"priority,omitempty"`
+	// Merge the selected issues into one. Only supported by BulkUpdate.
+	Merge *bool `json:"merge,omitempty"`
+	// Discard the selected issues and drop their future events.
+	Discard *bool `json:"discard,omitempty"`
+}
+
+// Update an issue.
+// https://docs.sentry.io/api/events/update-an-issue/
+func (s *IssuesService) Update(ctx context.Context, organizationSlug string, issueID string, params *UpdateIssueParams) (*Issue, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/issues/%v/", organizationSlug, issueID)
+	req, err := s.client.NewRequest("PUT", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issue := new(Issue)
+	resp, err := s.client.Do(ctx, req, issue)
+	if err != nil {
+		return nil, resp, err
+	}
+	return issue, resp, nil
+}
+
+// Delete an issue.
+// https://docs.sentry.io/api/events/remove-an-issue/
+func (s *IssuesService) Delete(ctx context.Context, organizationSlug string, issueID string) (*Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/issues/%v/", organizationSlug, issueID)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// BulkIssuesParams select the issues a bulk operation applies to.
+// If no IDs are given, the operation applies to the issues matching the
+// query, up to a maximum of 1000. Either IDs or a query must be set.
+type BulkIssuesParams struct {
+	ID          []string `url:"id,omitempty"`
+	Query       *string  `url:"query,omitempty"`
+	Status      *string  `url:"status,omitempty"`
+	Environment []string `url:"environment,omitempty"`
+	Project     []int    `url:"project,omitempty"`
+}
+
+// selects reports whether the selection sets IDs or a query, as Sentry would
+// otherwise apply the operation to the issues matching its default query.
+func (p *BulkIssuesParams) selects() bool {
+	return p != nil && (len(p.ID) > 0 || (p.Query != nil && *p.Query != ""))
+}
+
+// IssueMerge represents the result of merging issues.
+type IssueMerge struct {
+	Parent   *string  `json:"parent,omitempty"`
+	Children []string `json:"children,omitempty"`
+}
+
+// BulkUpdateIssuesResult holds the changes applied by IssuesService.BulkUpdate.
+type BulkUpdateIssuesResult struct {
+	Status        *string             `json:"status,omitempty"`
+	StatusDetails *IssueStatusDetails `json:"statusDetails,omitempty"`
+	Substatus     *string             `json:"substatus,omitempty"`
+	AssignedTo    *IssueAssignee      `json:"assignedTo,omitempty"`
+	HasSeen       *bool               `json:"hasSeen,omitempty"`
+	IsBookmarked  *bool               `json:"isBookmarked,omitempty"`
+	IsSubscribed  *bool               `json:"isSubscribed,omitempty"`
+	IsPublic      *bool               `json:"isPublic,omitempty"`
+	ShareID       *string             `json:"shareId,omitempty"`
+	Priority      *string             `json:"priority,omitempty"`
+	Merge         *IssueMerge         `json:"merge,omitempty"`
+}
+
+// BulkUpdate resolves, ignores, assigns, merges, bookmarks or marks as seen
+// the selected issues of an organization.
+// The selection must set IDs or a query, as Sentry would otherwise update the
+// issues matching its default query.
+// https://docs.sentry.io/api/events/bulk-mutate-an-organizations-issues/
+func (s *IssuesService) BulkUpdate(ctx context.Context, organizationSlug string, selection *BulkIssuesParams, params *UpdateIssueParams) (*BulkUpdateIssuesResult, *Response, error) {
+	if !selection.selects() {
+		return nil, nil, errors.New("sentry: bulk update requires issue IDs or a query")
+	}
+
+	u := fmt.Sprintf("0/organizations/%v/issues/", organizationSlug)
+	u, err := addQuery(u, selection)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("PUT", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(BulkUpdateIssuesResult)
+	resp, err := s.client.Do(ctx, req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return result, resp, nil
+}
+
+// BulkDelete removes the selected issues of an organization.
+// The selection must set IDs or a query, as Sentry would otherwise remove the
+// issues matching its default query.
+// https://docs.sentry.io/api/events/bulk-remove-an-organizations-issues/
+func (s *IssuesService) BulkDelete(ctx context.Context, organizationSlug string, selection *BulkIssuesParams) (*Response, error) {
+	if !selection.selects() {
+		return nil, errors.New("sentry: bulk delete requires issue IDs or a query")
+	}
+
+	u := fmt.Sprintf("0/organizations/%v/issues/", organizationSlug)
+	u, err := addQuery(u, selection)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,377 @@
+package sentry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssuesService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/issues/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assert.Equal(t, url.Values{
+			"query":       {"is:unresolved"},
+			"statsPeriod": {"24h"},
+			"environment": {"production", "staging"},
+			"project":     {"1", "2"},
+			"sort":        {"freq"},
+			"limit":       {"25"},
+		}, r.URL.Query())
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[
+			{
+				"annotations": [],
+				"assignedTo": null,
+				"count": "1",
+				"culprit": "raven.scripts.runner in main",
+				"firstSeen": "2018-11-06T21:19:55Z",
+				"hasSeen": false,
+				"id": "1",
+				"isBookmarked": false,
+				"isPublic": false,
+				"isSubscribed": true,
+				"lastSeen": "2018-11-06T21:19:55Z",
+				"level": "error",
+				"logger": null,
+				"metadata": {
+					"title": "This is an example Python exception"
+				},
+				"numComments": 0,
+				"permalink": "https://sentry.io/the-interstellar-jurisdiction/pump-station/issues/1/",
+				"project": {
+					"id": "2",
+					"name": "Pump Station",
+					"slug": "pump-station"
+				},
+				"shareId": null,
+				"shortId": "PUMP-STATION-1",
+				"stats": {
+					"24h": [
+						[1541455200, 473],
+						[1541458800, 914]
+					]
+				},
+				"status": "unresolved",
+				"statusDetails": {},
+				"subscriptionDetails": null,
+				"title": "This is an example Python exception",
+				"type": "default",
+				"userCount": 0
+			}
+		]`)
+	})
+
+	ctx := context.Background()
+	issues, _, err := client.Issues.List(ctx, "the-interstellar-jurisdiction", &ListIssuesParams{
+		Query:       String("is:unresolved"),
+		StatsPeriod: String("24h"),
+		Environment: []string{"production", "staging"},
+		Project:     []int{1, 2},
+		Sort:        String("freq"),
+		Limit:       Int(25),
+	})
+	assert.NoError(t, err)
+
+	expected := []*Issue{
+		{
+			Count:        String("1"),
+			Culprit:      String("raven.scripts.runner in main"),
+			FirstSeen:    Time(mustParseTime("2018-11-06T21:19:55Z")),
+			HasSeen:      Bool(false),
+			ID:           String("1"),
+			IsBookmarked: Bool(false),
+			IsPublic:     Bool(false),
+			IsSubscribed: Bool(true),
+			LastSeen:     Time(mustParseTime("2018-11-06T21:19:55Z")),
+			Level:        String("error"),
+			Metadata: map[string]interface{}{
+				"title": "This is an example Python exception",
+			},
+			NumComments: Int(0),
+			Permalink:   String("https://sentry.io/the-interstellar-jurisdiction/pump-station/issues/1/"),
+			Project: &IssueProject{
+				ID:   String("2"),
+				Name: String("Pump Station"),
+				Slug: String("pump-station"),
+			},
+			ShortID: String("PUMP-STATION-1"),
+			Stats: map[string][][2]float64{
+				"24h": {
+					{1541455200, 473},
+					{1541458800, 914},
+				},
+			},
+			Status:        String("unresolved"),
+			StatusDetails: &IssueStatusDetails{},
+			Title:         String("This is an example Python exception"),
+			Type:          String("default"),
+			UserCount:     Int(0),
+		},
+	}
+	assert.Equal(t, expected, issues)
+}
+
+func TestIssuesService_ListByProject(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/issues/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"query": "is:unresolved", "statsPeriod": "14d", "cursor": "100:1:0"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"id": "1", "shortId": "PUMP-STATION-1"}]`)
+	})
+
+	ctx := context.Background()
+	issues, _, err := client.Issues.ListByProject(ctx, "the-interstellar-jurisdiction", "pump-station", &ListProjectIssuesParams{
+		ListCursorParams: ListCursorParams{Cursor: "100:1:0"},
+		Query:            String("is:unresolved"),
+		StatsPeriod:      String("14d"),
+	})
+	assert.NoError(t, err)
+
+	expected := []*Issue{
+		{ID: String("1"), ShortID: String("PUMP-STATION-1")},
+	}
+	assert.Equal(t, expected, issues)
+}
+
+func TestIssuesService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/issues/1/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"id": "1",
+			"title": "This is an example Python exception",
+			"status": "ignored",
+			"substatus": "archived_until_condition_met",
+			"statusDetails": {
+				"ignoreCount": 100,
+				"ignoreWindow": 60
+			},
+			"assignedTo": {
+				"type": "team",
+				"id": "2",
+				"name": "powerful-abolitionist"
+			},
+			"userReportCount": 0
+		}`)
+	})
+
+	ctx := context.Background()
+	issue, _, err := client.Issues.Get(ctx, "the-interstellar-jurisdiction", "1")
+	assert.NoError(t, err)
+
+	expected := &Issue{
+		ID:        String("1"),
+		Title:     String("This is an example Python exception"),
+		Status:    String(IssueStatusIgnored),
+		Substatus: String(IssueSubstatusArchivedUntilCondition),
+		StatusDetails: &IssueStatusDetails{
+			IgnoreCount:  Int(100),
+			IgnoreWindow: Int(60),
+		},
+		AssignedTo: &IssueAssignee{
+			Type: String("team"),
+			ID:   String("2"),
+			Name: String("powerful-abolitionist"),
+		},
+		UserReportCount: Int(0),
+	}
+	assert.Equal(t, expected, issue)
+}
+
+func TestIssuesService_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/issues/1/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		assertPostJSON(t, map[string]interface{}{
+			"status":       "resolved",
+			"assignedTo":   "user:1",
+			"isBookmarked": true,
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id": "1", "status": "resolved", "isBookmarked": true}`)
+	})
+
+	ctx := context.Background()
+	issue, _, err := client.Issues.Update(ctx, "the-interstellar-jurisdiction", "1", &UpdateIssueParams{
+		Status:       String(IssueStatusResolved),
+		AssignedTo:   String("user:1"),
+		IsBookmarked: Bool(true),
+	})
+	assert.NoError(t, err)
+
+	expected := &Issue{
+		ID:           String("1"),
+		Status:       String("resolved"),
+		IsBookmarked: Bool(true),
+	}
+	assert.Equal(t, expected, issue)
+}
+
+func TestIssuesService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/issues/1/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	ctx := context.Background()
+	_, err := client.Issues.Delete(ctx, "the-interstellar-jurisdiction", "1")
+	assert.NoError(t, err)
+}
+
+func TestIssuesService_BulkUpdate_ignore(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/issues/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		assert.Equal(t, url.Values{"id": {"1", "2"}}, r.URL.Query())
+		assertPostJSON(t, map[string]interface{}{
+			"status": "ignored",
+			"statusDetails": map[string]interface{}{
+				"ignoreUserCount":  json.Number("10"),
+				"ignoreUserWindow": json.Number("60"),
+			},
+			"hasSeen": true,
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"status": "ignored",
+			"statusDetails": {
+				"ignoreUserCount": 10,
+				"ignoreUserWindow": 60
+			},
+			"hasSeen": true
+		}`)
+	})
+
+	ctx := context.Background()
+	result, _, err := client.Issues.BulkUpdate(ctx, "the-interstellar-jurisdiction", &BulkIssuesParams{
+		ID: []string{"1", "2"},
+	}, &UpdateIssueParams{
+		Status: String(IssueStatusIgnored),
+		StatusDetails: &IssueStatusDetails{
+			IgnoreUserCount:  Int(10),
+			IgnoreUserWindow: Int(60),
+		},
+		HasSeen: Bool(true),
+	})
+	assert.NoError(t, err)
+
+	expected := &BulkUpdateIssuesResult{
+		Status: String("ignored"),
+		StatusDetails: &IssueStatusDetails{
+			IgnoreUserCount:  Int(10),
+			IgnoreUserWindow: Int(60),
+		},
+		HasSeen: Bool(true),
+	}
+	assert.Equal(t, expected, result)
+}
+
+func TestIssuesService_BulkUpdate_merge(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/issues/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		assert.Equal(t, url.Values{"id": {"1", "2", "3"}}, r.URL.Query())
+		assertPostJSON(t, map[string]interface{}{
+			"merge": true,
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"merge": {"parent": "1", "children": ["2", "3"]}}`)
+	})
+
+	ctx := context.Background()
+	result, _, err := client.Issues.BulkUpdate(ctx, "the-interstellar-jurisdiction", &BulkIssuesParams{
+		ID: []string{"1", "2", "3"},
+	}, &UpdateIssueParams{
+		Merge: Bool(true),
+	})
+	assert.NoError(t, err)
+
+	expected := &BulkUpdateIssuesResult{
+		Merge: &IssueMerge{
+			Parent:   String("1"),
+			Children: []string{"2", "3"},
+		},
+	}
+	assert.Equal(t, expected, result)
+}
+
+func TestIssuesService_BulkDelete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/issues/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		assert.Equal(t, url.Values{"query": {"is:resolved"}, "project": {"2"}}, r.URL.Query())
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Issues.BulkDelete(ctx, "the-interstellar-jurisdiction", &BulkIssuesParams{
+		Query:   String("is:resolved"),
+		Project: []int{2},
+	})
+	assert.NoError(t, err)
+}
+
+func TestIssuesService_BulkUpdate_emptySelection(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/issues/", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected request")
+	})
+
+	ctx := context.Background()
+	for _, selection := range []*BulkIssuesParams{
+		nil,
+		{},
+		{Query: String("")},
+		{Project: []int{2}, Environment: []string{"production"}},
+	} {
+		_, _, err := client.Issues.BulkUpdate(ctx, "the-interstellar-jurisdiction", selection, &UpdateIssueParams{Discard: Bool(true)})
+		assert.EqualError(t, err, "sentry: bulk update requires issue IDs or a query")
+	}
+}
+
+func TestIssuesService_BulkDelete_emptySelection(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/issues/", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected request")
+	})
+
+	ctx := context.Background()
+	for _, selection := range []*BulkIssuesParams{
+		nil,
+		{},
+		{Query: String("")},
+		{Project: []int{2}, Environment: []string{"production"}},
+	} {
+		_, err := client.Issues.BulkDelete(ctx, "the-interstellar-jurisdiction", selection)
+		assert.EqualError(t, err, "sentry: bulk delete requires issue IDs or a query")
+	}
+}
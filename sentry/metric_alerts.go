@@ -26,6 +26,8 @@ type MetricAlert struct {
 	Owner            *string               `json:"owner,omitempty"`
 	DateCreated      *time.Time            `json:"dateCreated,omitempty"`
 	TaskUUID         *string               `json:"uuid,omitempty"` // This is actually the UUID of the async task that can be spawned to create the metric
+	// Snooze is whether the alert rule is snoozed for the current user.
+	Snooze *bool `json:"snooze,omitempty"`
 }
 
 // MetricAlertTaskDetail represents the inline struct Sentry defines for task details
@@ -85,7 +87,16 @@ func (s *MetricAlertsService) List(ctx context.Context, organizationSlug string,
 	return alerts, resp, nil
 }
 
-// Get details on an issue alert.
+// ListAll returns all Alert Rules configured for a project, following pagination.
+func (s *MetricAlertsService) ListAll(ctx context.Context, organizationSlug string, projectSlug string) ([]*MetricAlert, *Response, error) {
+	return NewPager(func(ctx context.Context, cursor string) ([]*MetricAlert, *Response, error) {
+		return s.List(ctx, organizationSlug, projectSlug, &ListCursorParams{Cursor: cursor})
+	}).All(ctx)
+}
+
+// Get details on a metric alert. The Alert Rule endpoint is scoped to the
+// organization: projectSlug is ignored, so Get also returns Alert Rules
+// created with CreateForOrganization.
 func (s *MetricAlertsService) Get(ctx context.Context, organizationSlug string, projectSlug string, id string) (*MetricAlert, *Response, error) {
 	// TODO: Remove projectSlug argument
 	u := fmt.Sprintf("0/organizations/%v/alert-rules/%v/", organizationSlug, id)
@@ -152,44 +163,179 @@ func (s *MetricAlertsService) Update(ctx context.Context, organizationSlug strin
 	return alert, resp, nil
 }
 
+// getMetricAlertFromMetricAlertTaskDetail is called when Sentry offloads the metric alert creation or update process to an async task
+// and sends us back the task's uuid. The task is polled according to the client's AsyncTaskPolicy.
 func (s *MetricAlertsService) getMetricAlertFromMetricAlertTaskDetail(ctx context.Context, organizationSlug string, projectSlug string, taskUUID string) (*MetricAlert, *Response, error) {
 	u := fmt.Sprintf("0/projects/%v/%v/alert-rule-task/%v/", organizationSlug, projectSlug, taskUUID)
+	return pollAsyncTask(ctx, s.client.AsyncTaskPolicy, taskUUID, func(ctx context.Context) (*string, *MetricAlert, *string, *Response, error) {
+		req, err := s.client.NewRequest("GET", u, nil)
+		if err != nil {
+			return nil, nil, nil, nil, err
+		}
+
+		taskDetail := new(MetricAlertTaskDetail)
+		resp, err := s.client.Do(ctx, req, taskDetail)
+		if err != nil {
+			return nil, nil, nil, resp, err
+		}
+		return taskDetail.Status, taskDetail.AlertRule, taskDetail.Error, resp, nil
+	})
+}
+
+// Delete an Alert Rule.
+func (s *MetricAlertsService) Delete(ctx context.Context, organizationSlug string, projectSlug string, alertRuleID string) (*Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/alert-rules/%v/", organizationSlug, projectSlug, alertRuleID)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListOrganizationMetricAlertsParams are the parameters for
+// MetricAlertsService.ListForOrganization.
+type ListOrganizationMetricAlertsParams struct {
+	ListCursorParams
+
+	// The IDs of the projects to filter by. All accessible projects if empty.
+	Project []int `url:"project,omitempty"`
+	PerPage *int  `url:"per_page,omitempty"`
+}
+
+// ListForOrganization lists the Alert Rules of an organization, which may span
+// several projects.
+func (s *MetricAlertsService) ListForOrganization(ctx context.Context, organizationSlug string, params *ListOrganizationMetricAlertsParams) ([]*MetricAlert, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/alert-rules/", organizationSlug)
+	u, err := addQuery(u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	var resp *Response
-	for i := 0; i < 5; i++ {
-		time.Sleep(5 * time.Second)
+	alerts := []*MetricAlert{}
+	resp, err := s.client.Do(ctx, req, &alerts)
+	if err != nil {
+		return nil, resp, err
+	}
+	return alerts, resp, nil
+}
 
-		taskDetail := new(MetricAlertTaskDetail)
-		resp, err := s.client.Do(ctx, req, taskDetail)
-		if err != nil {
-			return nil, resp, err
-		}
+// ListAllForOrganization returns all the Alert Rules of an organization, following pagination.
+func (s *MetricAlertsService) ListAllForOrganization(ctx context.Context, organizationSlug string, params *ListOrganizationMetricAlertsParams) ([]*MetricAlert, *Response, error) {
+	p := ListOrganizationMetricAlertsParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewPager(func(ctx context.Context, cursor string) ([]*MetricAlert, *Response, error) {
+		p.Cursor = cursor
+		return s.ListForOrganization(ctx, organizationSlug, &p)
+	}).All(ctx)
+}
+
+// CreateForOrganization creates an Alert Rule for the projects set in params.
+// Projects must be set if Sentry may create the Alert Rule in an async task.
+func (s *MetricAlertsService) CreateForOrganization(ctx context.Context, organizationSlug string, params *MetricAlert) (*MetricAlert, *Response, error) {
+	if metricAlertMayRunAsync(params) && len(params.Projects) == 0 {
+		return nil, nil, errors.New("sentry: projects are required for alert rules with channel lookups")
+	}
 
-		if resp.StatusCode == 404 {
-			return nil, resp, fmt.Errorf("cannot find metric alert creation task with UUID %v", taskUUID)
+	u := fmt.Sprintf("0/organizations/%v/alert-rules/", organizationSlug)
+	req, err := s.client.NewRequest("POST", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	alert := new(MetricAlert)
+	resp, err := s.client.Do(ctx, req, alert)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if resp.StatusCode == 202 {
+		return s.getOrganizationMetricAlertFromTaskDetail(ctx, organizationSlug, params, alert, resp)
+	}
+
+	return alert, resp, nil
+}
+
+// UpdateForOrganization updates an Alert Rule of an organization.
+// Projects must be set if Sentry may update the Alert Rule in an async task.
+func (s *MetricAlertsService) UpdateForOrganization(ctx context.Context, organizationSlug string, alertRuleID string, params *MetricAlert) (*MetricAlert, *Response, error) {
+	if metricAlertMayRunAsync(params) && len(params.Projects) == 0 {
+		return nil, nil, errors.New("sentry: projects are required for alert rules with channel lookups")
+	}
+
+	u := fmt.Sprintf("0/organizations/%v/alert-rules/%v/", organizationSlug, alertRuleID)
+	req, err := s.client.NewRequest("PUT", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	alert := new(MetricAlert)
+	resp, err := s.client.Do(ctx, req, alert)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if resp.StatusCode == 202 {
+		return s.getOrganizationMetricAlertFromTaskDetail(ctx, organizationSlug, params, alert, resp)
+	}
+
+	return alert, resp, nil
+}
+
+// Action types whose channel Sentry may look up in an async task.
+var metricAlertAsyncActionTypes = map[string]bool{
+	"slack":   true,
+	"msteams": true,
+	"discord": true,
+}
+
+// metricAlertMayRunAsync reports whether Sentry may offload the change of an
+// Alert Rule to an async task, which it does to look up the channel of an
+// action when no input channel ID is given. The task is only exposed under a
+// project, so the change must be refused before it is sent if the Alert Rule
+// has no projects: once Sentry answers, the change has been made.
+func metricAlertMayRunAsync(params *MetricAlert) bool {
+	if params == nil {
+		return false
+	}
+	for _, trigger := range params.Triggers {
+		if trigger == nil {
+			continue
 		}
-		if taskDetail.Status != nil && taskDetail.AlertRule != nil {
-			if *taskDetail.Status == "success" {
-				return taskDetail.AlertRule, resp, err
-			} else if *taskDetail.Status == "failed" {
-				if taskDetail != nil {
-					return taskDetail.AlertRule, resp, errors.New(*taskDetail.Error)
-				}
-
-				return taskDetail.AlertRule, resp, errors.New("error while running the metric alert creation task")
+		for _, action := range trigger.Actions {
+			if action == nil || action.Type == nil || !metricAlertAsyncActionTypes[*action.Type] {
+				continue
+			}
+			if action.InputChannelID == nil || *action.InputChannelID == "" {
+				return true
 			}
 		}
 	}
-	return nil, resp, errors.New("getting the status of the metric alert creation from Sentry took too long")
+	return false
 }
 
-// Delete an Alert Rule.
-func (s *MetricAlertsService) Delete(ctx context.Context, organizationSlug string, projectSlug string, alertRuleID string) (*Response, error) {
-	u := fmt.Sprintf("0/projects/%v/%v/alert-rules/%v/", organizationSlug, projectSlug, alertRuleID)
+// getOrganizationMetricAlertFromTaskDetail waits for the async task spawned by an organization Alert Rule change.
+// Sentry only exposes the task under a project, so the first project of the Alert Rule is used.
+func (s *MetricAlertsService) getOrganizationMetricAlertFromTaskDetail(ctx context.Context, organizationSlug string, params *MetricAlert, alert *MetricAlert, resp *Response) (*MetricAlert, *Response, error) {
+	if alert.TaskUUID == nil {
+		return nil, resp, errors.New("missing task uuid")
+	}
+	if params == nil || len(params.Projects) == 0 {
+		return nil, resp, errors.New("missing project to get the async task from")
+	}
+	return s.getMetricAlertFromMetricAlertTaskDetail(ctx, organizationSlug, params.Projects[0], *alert.TaskUUID)
+}
+
+// DeleteForOrganization deletes an Alert Rule of an organization.
+func (s *MetricAlertsService) DeleteForOrganization(ctx context.Context, organizationSlug string, alertRuleID string) (*Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/alert-rules/%v/", organizationSlug, alertRuleID)
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -197,3 +343,32 @@ func (s *MetricAlertsService) Delete(ctx context.Context, organizationSlug strin
 
 	return s.client.Do(ctx, req, nil)
 }
+
+// Snooze mutes the notifications of an Alert Rule, for the current user or
+// for everyone.
+func (s *MetricAlertsService) Snooze(ctx context.Context, organizationSlug string, alertRuleID string, params *AlertSnoozeParams) (*AlertSnooze, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/alert-rules/%v/snooze/", organizationSlug, alertRuleID)
+	req, err := s.client.NewRequest("POST", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	snooze := new(AlertSnooze)
+	resp, err := s.client.Do(ctx, req, snooze)
+	if err != nil {
+		return nil, resp, err
+	}
+	return snooze, resp, nil
+}
+
+// Unsnooze removes the snooze of an Alert Rule for the given target.
+func (s *MetricAlertsService) Unsnooze(ctx context.Context, organizationSlug string, alertRuleID string, target string) (*Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/alert-rules/%v/snooze/", organizationSlug, alertRuleID)
+	params := &AlertSnoozeParams{Target: &target}
+	req, err := s.client.NewRequest("DELETE", u, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
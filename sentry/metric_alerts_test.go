@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -214,6 +215,7 @@ func TestMetricAlertService_Get(t *testing.T) {
 func TestMetricAlertsService_CreateWithAsyncTask(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
+	client.AsyncTaskPolicy = &AsyncTaskPolicy{Interval: time.Millisecond}
 
 	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/alert-rule-task/fakeuuid/", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
@@ -695,3 +697,193 @@ func TestMetricAlertService_Delete(t *testing.T) {
 	_, err := client.MetricAlerts.Delete(ctx, "the-interstellar-jurisdiction", "pump-station", "12345")
 	require.NoError(t, err)
 }
+
+func TestMetricAlertsService_ListForOrganization(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/alert-rules/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"project": "1", "per_page": "50"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[
+			{
+				"id": "12345",
+				"name": "pump-station-alert",
+				"aggregate": "count()",
+				"projects": ["pump-station", "power-plant"],
+				"snooze": true
+			}
+		]`)
+	})
+
+	ctx := context.Background()
+	alerts, _, err := client.MetricAlerts.ListForOrganization(ctx, "the-interstellar-jurisdiction", &ListOrganizationMetricAlertsParams{
+		Project: []int{1},
+		PerPage: Int(50),
+	})
+	require.NoError(t, err)
+
+	expected := []*MetricAlert{
+		{
+			ID:        String("12345"),
+			Name:      String("pump-station-alert"),
+			Aggregate: String("count()"),
+			Projects:  []string{"pump-station", "power-plant"},
+			Snooze:    Bool(true),
+		},
+	}
+	assert.Equal(t, expected, alerts)
+}
+
+func TestMetricAlertsService_CreateForOrganizationWithAsyncTask(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.AsyncTaskPolicy = &AsyncTaskPolicy{Interval: time.Millisecond}
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/alert-rules/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assertPostJSON(t, map[string]interface{}{
+			"name":     "pump-station-alert",
+			"projects": []interface{}{"pump-station", "power-plant"},
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"uuid": "fakeuuid"}`)
+	})
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/alert-rule-task/fakeuuid/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"status": "success", "error": null, "alertRule": {"id": "12345", "name": "pump-station-alert"}}`)
+	})
+
+	ctx := context.Background()
+	alert, _, err := client.MetricAlerts.CreateForOrganization(ctx, "the-interstellar-jurisdiction", &MetricAlert{
+		Name:     String("pump-station-alert"),
+		Projects: []string{"pump-station", "power-plant"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &MetricAlert{ID: String("12345"), Name: String("pump-station-alert")}, alert)
+}
+
+func TestMetricAlertsService_CreateForOrganization_asyncWithoutProjects(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/alert-rules/", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected request")
+	})
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/alert-rules/12345/", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected request")
+	})
+
+	params := &MetricAlert{
+		Name: String("pump-station-alert"),
+		Triggers: []*MetricAlertTrigger{
+			{
+				Label: String("critical"),
+				Actions: []*MetricAlertTriggerAction{
+					{
+						Type:             String("slack"),
+						TargetType:       String("specific"),
+						TargetIdentifier: &Int64OrString{IsString: true, StringVal: "#alerts"},
+						IntegrationID:    Int(123),
+					},
+				},
+			},
+		},
+	}
+
+	ctx := context.Background()
+	_, _, err := client.MetricAlerts.CreateForOrganization(ctx, "the-interstellar-jurisdiction", params)
+	assert.EqualError(t, err, "sentry: projects are required for alert rules with channel lookups")
+	_, _, err = client.MetricAlerts.UpdateForOrganization(ctx, "the-interstellar-jurisdiction", "12345", params)
+	assert.EqualError(t, err, "sentry: projects are required for alert rules with channel lookups")
+}
+
+func TestMetricAlertsService_UpdateForOrganization(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/alert-rules/12345/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		assertPostJSON(t, map[string]interface{}{
+			"name": "pump-station-alert",
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id": "12345", "name": "pump-station-alert"}`)
+	})
+
+	ctx := context.Background()
+	alert, _, err := client.MetricAlerts.UpdateForOrganization(ctx, "the-interstellar-jurisdiction", "12345", &MetricAlert{
+		Name: String("pump-station-alert"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &MetricAlert{ID: String("12345"), Name: String("pump-station-alert")}, alert)
+}
+
+func TestMetricAlertsService_DeleteForOrganization(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/alert-rules/12345/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+	})
+
+	ctx := context.Background()
+	_, err := client.MetricAlerts.DeleteForOrganization(ctx, "the-interstellar-jurisdiction", "12345")
+	require.NoError(t, err)
+}
+
+func TestMetricAlertsService_Snooze(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/alert-rules/12345/snooze/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assertPostJSON(t, map[string]interface{}{
+			"target": "everyone",
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{
+			"ownerId": 1,
+			"userId": "everyone",
+			"until": "forever",
+			"dateAdded": "2024-10-17T12:00:00Z",
+			"ruleId": null,
+			"alertRuleId": 12345
+		}`)
+	})
+
+	ctx := context.Background()
+	snooze, _, err := client.MetricAlerts.Snooze(ctx, "the-interstellar-jurisdiction", "12345", &AlertSnoozeParams{
+		Target: String(AlertSnoozeTargetEveryone),
+	})
+	require.NoError(t, err)
+
+	expected := &AlertSnooze{
+		OwnerID:     Int(1),
+		UserID:      &Int64OrString{IsString: true, StringVal: "everyone"},
+		DateAdded:   Time(mustParseTime("2024-10-17T12:00:00Z")),
+		AlertRuleID: Int(12345),
+	}
+	assert.Equal(t, expected, snooze)
+}
+
+func TestMetricAlertsService_Unsnooze(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/alert-rules/12345/snooze/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		assertPostJSON(t, map[string]interface{}{
+			"target": "me",
+		}, r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.MetricAlerts.Unsnooze(ctx, "the-interstellar-jurisdiction", "12345", AlertSnoozeTargetMe)
+	require.NoError(t, err)
+}
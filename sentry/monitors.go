@@ -0,0 +1,377 @@
+package sentry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Monitor schedule types.
+const (
+	MonitorScheduleTypeCrontab  = "crontab"
+	MonitorScheduleTypeInterval = "interval"
+)
+
+// Monitor schedule interval units.
+const (
+	MonitorIntervalUnitMinute = "minute"
+	MonitorIntervalUnitHour   = "hour"
+	MonitorIntervalUnitDay    = "day"
+	MonitorIntervalUnitWeek   = "week"
+	MonitorIntervalUnitMonth  = "month"
+	MonitorIntervalUnitYear   = "year"
+)
+
+// Monitor statuses.
+const (
+	MonitorStatusActive   = "active"
+	MonitorStatusDisabled = "disabled"
+)
+
+// Monitor check-in statuses.
+const (
+	MonitorCheckInStatusInProgress = "in_progress"
+	MonitorCheckInStatusOK         = "ok"
+	MonitorCheckInStatusError      = "error"
+	MonitorCheckInStatusMissed     = "missed"
+	MonitorCheckInStatusTimeout    = "timeout"
+)
+
+// Monitor represents a Sentry cron monitor.
+// https://github.com/getsentry/sentry/blob/24.10.0/src/sentry/monitors/serializers.py
+type Monitor struct {
+	ID           *string               `json:"id,omitempty"`
+	Slug         *string               `json:"slug,omitempty"`
+	Name         *string               `json:"name,omitempty"`
+	Status       *string               `json:"status,omitempty"`
+	IsMuted      *bool                 `json:"isMuted,omitempty"`
+	Config       *MonitorConfig        `json:"config,omitempty"`
+	Owner        *MonitorOwner         `json:"owner,omitempty"`
+	Project      *MonitorProject       `json:"project,omitempty"`
+	Environments []*MonitorEnvironment `json:"environments,omitempty"`
+	DateCreated  *time.Time            `json:"dateCreated,omitempty"`
+}
+
+// MonitorConfig represents the schedule and alerting configuration of a monitor.
+type MonitorConfig struct {
+	// ScheduleType is either MonitorScheduleTypeCrontab or MonitorScheduleTypeInterval
+	// and must match Schedule.
+	ScheduleType *string          `json:"schedule_type,omitempty"`
+	Schedule     *MonitorSchedule `json:"schedule,omitempty"`
+	// CheckinMargin is the number of minutes after the expected time a
+	// check-in is considered missed.
+	CheckinMargin *int `json:"checkin_margin,omitempty"`
+	// MaxRuntime is the number of minutes an in-progress check-in may run
+	// before it is considered timed out.
+	MaxRuntime *int    `json:"max_runtime,omitempty"`
+	Timezone   *string `json:"timezone,omitempty"`
+	// FailureIssueThreshold is the number of consecutive failed check-ins
+	// before an issue is created.
+	FailureIssueThreshold *int `json:"failure_issue_threshold,omitempty"`
+	// RecoveryThreshold is the number of consecutive successful check-ins
+	// before the issue is resolved.
+	RecoveryThreshold *int `json:"recovery_threshold,omitempty"`
+}
+
+// MonitorSchedule is a monitor schedule, either a crontab expression such as
+// "0 * * * *" or an interval such as every 2 hours.
+type MonitorSchedule struct {
+	IsCrontab    bool
+	IsInterval   bool
+	Crontab      string
+	Interval     int
+	IntervalUnit string
+}
+
+// MonitorCrontabSchedule returns a crontab schedule.
+func MonitorCrontabSchedule(crontab string) *MonitorSchedule {
+	return &MonitorSchedule{IsCrontab: true, Crontab: crontab}
+}
+
+// MonitorIntervalSchedule returns an interval schedule, such as every 2 MonitorIntervalUnitHour.
+func MonitorIntervalSchedule(interval int, unit string) *MonitorSchedule {
+	return &MonitorSchedule{IsInterval: true, Interval: interval, IntervalUnit: unit}
+}
+
+var _ json.Unmarshaler = (*MonitorSchedule)(nil)
+var _ json.Marshaler = (*MonitorSchedule)(nil)
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *MonitorSchedule) UnmarshalJSON(data []byte) error {
+	var crontab string
+	if err := json.Unmarshal(data, &crontab); err == nil {
+		*s = MonitorSchedule{IsCrontab: true, Crontab: crontab}
+		return nil
+	}
+
+	var interval [2]interface{}
+	if err := json.Unmarshal(data, &interval); err == nil {
+		n, ok1 := interval[0].(float64)
+		unit, ok2 := interval[1].(string)
+		if ok1 && ok2 {
+			*s = MonitorSchedule{IsInterval: true, Interval: int(n), IntervalUnit: unit}
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unable to unmarshal as crontab or interval schedule: %s", string(data))
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s MonitorSchedule) MarshalJSON() ([]byte, error) {
+	if s.IsInterval {
+		return json.Marshal([]interface{}{s.Interval, s.IntervalUnit})
+	}
+	return json.Marshal(s.Crontab)
+}
+
+// MonitorOwner represents the user or team owning a monitor.
+type MonitorOwner struct {
+	Type  *string `json:"type,omitempty"`
+	ID    *string `json:"id,omitempty"`
+	Name  *string `json:"name,omitempty"`
+	Email *string `json:"email,omitempty"`
+}
+
+// MonitorProject represents the project of a monitor.
+type MonitorProject struct {
+	ID   *string `json:"id,omitempty"`
+	Slug *string `json:"slug,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+// MonitorEnvironment represents the state of a monitor in an environment.
+type MonitorEnvironment struct {
+	Name              *string    `json:"name,omitempty"`
+	Status            *string    `json:"status,omitempty"`
+	IsMuted           *bool      `json:"isMuted,omitempty"`
+	DateCreated       *time.Time `json:"dateCreated,omitempty"`
+	LastCheckIn       *time.Time `json:"lastCheckIn,omitempty"`
+	NextCheckIn       *time.Time `json:"nextCheckIn,omitempty"`
+	NextCheckInLatest *time.Time `json:"nextCheckInLatest,omitempty"`
+}
+
+// MonitorCheckIn represents a check-in of a monitor.
+type MonitorCheckIn struct {
+	ID            *string        `json:"id,omitempty"`
+	Status        *string        `json:"status,omitempty"`
+	Environment   *string        `json:"environment,omitempty"`
+	Duration      *int           `json:"duration,omitempty"`
+	DateCreated   *time.Time     `json:"dateCreated,omitempty"`
+	ExpectedTime  *time.Time     `json:"expectedTime,omitempty"`
+	MonitorConfig *MonitorConfig `json:"monitorConfig,omitempty"`
+}
+
+// MonitorsService provides methods for accessing Sentry cron monitor API endpoints.
+// https://docs.sentry.io/api/crons/
+type MonitorsService service
+
+// ListMonitorsParams are the parameters for MonitorsService.List.
+type ListMonitorsParams struct {
+	ListCursorParams
+
+	Query       *string  `url:"query,omitempty"`
+	Project     []int    `url:"project,omitempty"`
+	Environment []string `url:"environment,omitempty"`
+	Owner       *string  `url:"owner,omitempty"`
+}
+
+// List the monitors of an organization.
+// https://docs.sentry.io/api/crons/retrieve-monitors-for-an-organization/
+func (s *MonitorsService) List(ctx context.Context, organizationSlug string, params *ListMonitorsParams) ([]*Monitor, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/monitors/", organizationSlug)
+	u, err := addQuery(u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	monitors := []*Monitor{}
+	resp, err := s.client.Do(ctx, req, &monitors)
+	if err != nil {
+		return nil, resp, err
+	}
+	return monitors, resp, nil
+}
+
+// ListAll returns all monitors of an organization, following pagination.
+func (s *MonitorsService) ListAll(ctx context.Context, organizationSlug string, params *ListMonitorsParams) ([]*Monitor, *Response, error) {
+	p := ListMonitorsParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewPager(func(ctx context.Context, cursor string) ([]*Monitor, *Response, error) {
+		p.Cursor = cursor
+		return s.List(ctx, organizationSlug, &p)
+	}).All(ctx)
+}
+
+// Get a monitor.
+// https://docs.sentry.io/api/crons/retrieve-a-monitor/
+func (s *MonitorsService) Get(ctx context.Context, organizationSlug string, monitorSlug string) (*Monitor, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/monitors/%v/", organizationSlug, monitorSlug)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	monitor := new(Monitor)
+	resp, err := s.client.Do(ctx, req, monitor)
+	if err != nil {
+		return nil, resp, err
+	}
+	return monitor, resp, nil
+}
+
+// MonitorParams are the parameters for MonitorsService.Create and MonitorsService.Update.
+type MonitorParams struct {
+	// Project is the slug of the project of the monitor.
+	Project *string        `json:"project,omitempty"`
+	Name    *string        `json:"name,omitempty"`
+	Slug    *string        `json:"slug,omitempty"`
+	Status  *string        `json:"status,omitempty"`
+	IsMuted *bool          `json:"isMuted,omitempty"`
+	Config  *MonitorConfig `json:"config,omitempty"`
+	// Owner is the actor owning the monitor, such as "user:1" or "team:2".
+	Owner *string `json:"owner,omitempty"`
+}
+
+// Create a monitor.
+// https://docs.sentry.io/api/crons/create-a-monitor/
+func (s *MonitorsService) Create(ctx context.Context, organizationSlug string, params *MonitorParams) (*Monitor, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/monitors/", organizationSlug)
+	req, err := s.client.NewRequest("POST", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	monitor := new(Monitor)
+	resp, err := s.client.Do(ctx, req, monitor)
+	if err != nil {
+		return nil, resp, err
+	}
+	return monitor, resp, nil
+}
+
+// Update a monitor.
+// https://docs.sentry.io/api/crons/update-a-monitor/
+func (s *MonitorsService) Update(ctx context.Context, organizationSlug string, monitorSlug string, params *MonitorParams) (*Monitor, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/monitors/%v/", organizationSlug, monitorSlug)
+	req, err := s.client.NewRequest("PUT", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	monitor := new(Monitor)
+	resp, err := s.client.Do(ctx, req, monitor)
+	if err != nil {
+		return nil, resp, err
+	}
+	return monitor, resp, nil
+}
+
+// Mute a monitor, silencing its alerts.
+func (s *MonitorsService) Mute(ctx context.Context, organizationSlug string, monitorSlug string) (*Monitor, *Response, error) {
+	return s.Update(ctx, organizationSlug, monitorSlug, &MonitorParams{IsMuted: Bool(true)})
+}
+
+// Unmute a monitor.
+func (s *MonitorsService) Unmute(ctx context.Context, organizationSlug string, monitorSlug string) (*Monitor, *Response, error) {
+	return s.Update(ctx, organizationSlug, monitorSlug, &MonitorParams{IsMuted: Bool(false)})
+}
+
+// Delete a monitor.
+// https://docs.sentry.io/api/crons/delete-a-monitor-or-monitor-environments/
+func (s *MonitorsService) Delete(ctx context.Context, organizationSlug string, monitorSlug string) (*Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/monitors/%v/", organizationSlug, monitorSlug)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListMonitorCheckInsParams are the parameters for MonitorsService.ListCheckIns.
+type ListMonitorCheckInsParams struct {
+	ListCursorParams
+
+	Environment []string   `url:"environment,omitempty"`
+	StatsPeriod *string    `url:"statsPeriod,omitempty"`
+	Start       *time.Time `url:"start,omitempty"`
+	End         *time.Time `url:"end,omitempty"`
+}
+
+// ListCheckIns lists the check-ins of a monitor.
+// https://docs.sentry.io/api/crons/retrieve-check-ins-for-a-monitor/
+func (s *MonitorsService) ListCheckIns(ctx context.Context, organizationSlug string, monitorSlug string, params *ListMonitorCheckInsParams) ([]*MonitorCheckIn, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/monitors/%v/checkins/", organizationSlug, monitorSlug)
+	u, err := addQuery(u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	checkIns := []*MonitorCheckIn{}
+	resp, err := s.client.Do(ctx, req, &checkIns)
+	if err != nil {
+		return nil, resp, err
+	}
+	return checkIns, resp, nil
+}
+
+// MonitorCheckInParams are the parameters for MonitorsService.CreateCheckIn
+// and MonitorsService.UpdateCheckIn.
+type MonitorCheckInParams struct {
+	Status      *string `json:"status,omitempty"`
+	Environment *string `json:"environment,omitempty"`
+	// Duration of the job in milliseconds. If omitted when completing an
+	// in-progress check-in, it is computed from the check-in's start.
+	Duration *int `json:"duration,omitempty"`
+	// MonitorConfig creates or updates the monitor when checking in.
+	MonitorConfig *MonitorConfig `json:"monitor_config,omitempty"`
+}
+
+// CreateCheckIn sends a check-in for a monitor. Send an in-progress
+// check-in when a job starts and complete it with UpdateCheckIn, or send a
+// single check-in with the final status.
+func (s *MonitorsService) CreateCheckIn(ctx context.Context, organizationSlug string, monitorSlug string, params *MonitorCheckInParams) (*MonitorCheckIn, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/monitors/%v/checkins/", organizationSlug, monitorSlug)
+	req, err := s.client.NewRequest("POST", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	checkIn := new(MonitorCheckIn)
+	resp, err := s.client.Do(ctx, req, checkIn)
+	if err != nil {
+		return nil, resp, err
+	}
+	return checkIn, resp, nil
+}
+
+// UpdateCheckIn updates a check-in of a monitor. checkInID may be "latest"
+// to update the most recent check-in.
+func (s *MonitorsService) UpdateCheckIn(ctx context.Context, organizationSlug string, monitorSlug string, checkInID string, params *MonitorCheckInParams) (*MonitorCheckIn, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/monitors/%v/checkins/%v/", organizationSlug, monitorSlug, checkInID)
+	req, err := s.client.NewRequest("PUT", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	checkIn := new(MonitorCheckIn)
+	resp, err := s.client.Do(ctx, req, checkIn)
+	if err != nil {
+		return nil, resp, err
+	}
+	return checkIn, resp, nil
+}
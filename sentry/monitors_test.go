@@ -0,0 +1,343 @@
+package sentry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMonitorSchedule(t *testing.T) {
+	var crontab MonitorSchedule
+	assert.NoError(t, json.Unmarshal([]byte(`"0 * * * *"`), &crontab))
+	assert.Equal(t, *MonitorCrontabSchedule("0 * * * *"), crontab)
+
+	var interval MonitorSchedule
+	assert.NoError(t, json.Unmarshal([]byte(`[2, "hour"]`), &interval))
+	assert.Equal(t, *MonitorIntervalSchedule(2, MonitorIntervalUnitHour), interval)
+
+	var invalid MonitorSchedule
+	assert.Error(t, json.Unmarshal([]byte(`{"hour": 2}`), &invalid))
+
+	b, err := json.Marshal(MonitorIntervalSchedule(30, MonitorIntervalUnitMinute))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[30, "minute"]`, string(b))
+}
+
+func TestMonitorsService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/monitors/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"project": "2", "environment": "production"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[
+			{
+				"id": "b4c2a6ad-e6a1-4a1b-9b1b-2f5c3f1a8d7e",
+				"slug": "nightly-backup",
+				"name": "Nightly Backup",
+				"status": "active",
+				"isMuted": false,
+				"dateCreated": "2024-01-01T00:00:00Z",
+				"project": {"id": "2", "slug": "pump-station", "name": "Pump Station"},
+				"owner": {"type": "team", "id": "2", "name": "powerful-abolitionist"},
+				"config": {
+					"schedule_type": "crontab",
+					"schedule": "0 2 * * *",
+					"checkin_margin": 5,
+					"max_runtime": 30,
+					"timezone": "Europe/London",
+					"failure_issue_threshold": 2,
+					"recovery_threshold": 3,
+					"alert_rule_id": null
+				},
+				"environments": [
+					{
+						"name": "production",
+						"status": "ok",
+						"isMuted": false,
+						"dateCreated": "2024-01-01T00:00:00Z",
+						"lastCheckIn": "2024-01-02T02:00:05Z",
+						"nextCheckIn": "2024-01-03T02:00:00Z",
+						"nextCheckInLatest": "2024-01-03T02:05:00Z"
+					}
+				]
+			}
+		]`)
+	})
+
+	ctx := context.Background()
+	monitors, _, err := client.Monitors.List(ctx, "the-interstellar-jurisdiction", &ListMonitorsParams{
+		Project:     []int{2},
+		Environment: []string{"production"},
+	})
+	assert.NoError(t, err)
+
+	expected := []*Monitor{
+		{
+			ID:          String("b4c2a6ad-e6a1-4a1b-9b1b-2f5c3f1a8d7e"),
+			Slug:        String("nightly-backup"),
+			Name:        String("Nightly Backup"),
+			Status:      String(MonitorStatusActive),
+			IsMuted:     Bool(false),
+			DateCreated: Time(mustParseTime("2024-01-01T00:00:00Z")),
+			Project: &MonitorProject{
+				ID:   String("2"),
+				Slug: String("pump-station"),
+				Name: String("Pump Station"),
+			},
+			Owner: &MonitorOwner{
+				Type: String("team"),
+				ID:   String("2"),
+				Name: String("powerful-abolitionist"),
+			},
+			Config: &MonitorConfig{
+				ScheduleType:          String(MonitorScheduleTypeCrontab),
+				Schedule:              MonitorCrontabSchedule("0 2 * * *"),
+				CheckinMargin:         Int(5),
+				MaxRuntime:            Int(30),
+				Timezone:              String("Europe/London"),
+				FailureIssueThreshold: Int(2),
+				RecoveryThreshold:     Int(3),
+			},
+			Environments: []*MonitorEnvironment{
+				{
+					Name:              String("production"),
+					Status:            String("ok"),
+					IsMuted:           Bool(false),
+					DateCreated:       Time(mustParseTime("2024-01-01T00:00:00Z")),
+					LastCheckIn:       Time(mustParseTime("2024-01-02T02:00:05Z")),
+					NextCheckIn:       Time(mustParseTime("2024-01-03T02:00:00Z")),
+					NextCheckInLatest: Time(mustParseTime("2024-01-03T02:05:00Z")),
+				},
+			},
+		},
+	}
+	assert.Equal(t, expected, monitors)
+}
+
+func TestMonitorsService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/monitors/hourly-sync/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"slug": "hourly-sync",
+			"config": {"schedule_type": "interval", "schedule": [1, "hour"]}
+		}`)
+	})
+
+	ctx := context.Background()
+	monitor, _, err := client.Monitors.Get(ctx, "the-interstellar-jurisdiction", "hourly-sync")
+	assert.NoError(t, err)
+
+	expected := &Monitor{
+		Slug: String("hourly-sync"),
+		Config: &MonitorConfig{
+			ScheduleType: String(MonitorScheduleTypeInterval),
+			Schedule:     MonitorIntervalSchedule(1, MonitorIntervalUnitHour),
+		},
+	}
+	assert.Equal(t, expected, monitor)
+}
+
+func TestMonitorsService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/monitors/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assertPostJSON(t, map[string]interface{}{
+			"project": "pump-station",
+			"name":    "Hourly Sync",
+			"owner":   "team:2",
+			"config": map[string]interface{}{
+				"schedule_type":           "interval",
+				"schedule":                []interface{}{json.Number("1"), "hour"},
+				"checkin_margin":          json.Number("10"),
+				"max_runtime":             json.Number("20"),
+				"timezone":                "UTC",
+				"failure_issue_threshold": json.Number("1"),
+				"recovery_threshold":      json.Number("1"),
+			},
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"slug": "hourly-sync", "name": "Hourly Sync"}`)
+	})
+
+	ctx := context.Background()
+	monitor, _, err := client.Monitors.Create(ctx, "the-interstellar-jurisdiction", &MonitorParams{
+		Project: String("pump-station"),
+		Name:    String("Hourly Sync"),
+		Owner:   String("team:2"),
+		Config: &MonitorConfig{
+			ScheduleType:          String(MonitorScheduleTypeInterval),
+			Schedule:              MonitorIntervalSchedule(1, MonitorIntervalUnitHour),
+			CheckinMargin:         Int(10),
+			MaxRuntime:            Int(20),
+			Timezone:              String("UTC"),
+			FailureIssueThreshold: Int(1),
+			RecoveryThreshold:     Int(1),
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &Monitor{Slug: String("hourly-sync"), Name: String("Hourly Sync")}, monitor)
+}
+
+func TestMonitorsService_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/monitors/hourly-sync/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		assertPostJSON(t, map[string]interface{}{
+			"status": "disabled",
+			"config": map[string]interface{}{
+				"schedule_type": "crontab",
+				"schedule":      "*/30 * * * *",
+			},
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"slug": "hourly-sync", "status": "disabled"}`)
+	})
+
+	ctx := context.Background()
+	monitor, _, err := client.Monitors.Update(ctx, "the-interstellar-jurisdiction", "hourly-sync", &MonitorParams{
+		Status: String(MonitorStatusDisabled),
+		Config: &MonitorConfig{
+			ScheduleType: String(MonitorScheduleTypeCrontab),
+			Schedule:     MonitorCrontabSchedule("*/30 * * * *"),
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &Monitor{Slug: String("hourly-sync"), Status: String("disabled")}, monitor)
+}
+
+func TestMonitorsService_Mute(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var expectedMuted bool
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/monitors/hourly-sync/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		assertPostJSON(t, map[string]interface{}{"isMuted": expectedMuted}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"slug": "hourly-sync", "isMuted": %t}`, expectedMuted)
+	})
+
+	ctx := context.Background()
+	expectedMuted = true
+	monitor, _, err := client.Monitors.Mute(ctx, "the-interstellar-jurisdiction", "hourly-sync")
+	assert.NoError(t, err)
+	assert.Equal(t, Bool(true), monitor.IsMuted)
+
+	expectedMuted = false
+	monitor, _, err = client.Monitors.Unmute(ctx, "the-interstellar-jurisdiction", "hourly-sync")
+	assert.NoError(t, err)
+	assert.Equal(t, Bool(false), monitor.IsMuted)
+}
+
+func TestMonitorsService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/monitors/hourly-sync/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	ctx := context.Background()
+	_, err := client.Monitors.Delete(ctx, "the-interstellar-jurisdiction", "hourly-sync")
+	assert.NoError(t, err)
+}
+
+func TestMonitorsService_ListCheckIns(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/monitors/hourly-sync/checkins/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"statsPeriod": "24h"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[
+			{
+				"id": "9f3b4c5d-6e7f-4a8b-9c0d-1e2f3a4b5c6d",
+				"status": "ok",
+				"environment": "production",
+				"duration": 1500,
+				"dateCreated": "2024-01-02T02:00:05Z",
+				"expectedTime": "2024-01-02T02:00:00Z",
+				"monitorConfig": {"schedule_type": "interval", "schedule": [1, "hour"]}
+			}
+		]`)
+	})
+
+	ctx := context.Background()
+	checkIns, _, err := client.Monitors.ListCheckIns(ctx, "the-interstellar-jurisdiction", "hourly-sync", &ListMonitorCheckInsParams{
+		StatsPeriod: String("24h"),
+	})
+	assert.NoError(t, err)
+
+	expected := []*MonitorCheckIn{
+		{
+			ID:           String("9f3b4c5d-6e7f-4a8b-9c0d-1e2f3a4b5c6d"),
+			Status:       String(MonitorCheckInStatusOK),
+			Environment:  String("production"),
+			Duration:     Int(1500),
+			DateCreated:  Time(mustParseTime("2024-01-02T02:00:05Z")),
+			ExpectedTime: Time(mustParseTime("2024-01-02T02:00:00Z")),
+			MonitorConfig: &MonitorConfig{
+				ScheduleType: String(MonitorScheduleTypeInterval),
+				Schedule:     MonitorIntervalSchedule(1, MonitorIntervalUnitHour),
+			},
+		},
+	}
+	assert.Equal(t, expected, checkIns)
+}
+
+func TestMonitorsService_CheckIns(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/monitors/hourly-sync/checkins/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assertPostJSON(t, map[string]interface{}{
+			"status":      "in_progress",
+			"environment": "production",
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": "1", "status": "in_progress"}`)
+	})
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/monitors/hourly-sync/checkins/1/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		assertPostJSON(t, map[string]interface{}{
+			"status":   "ok",
+			"duration": json.Number("1500"),
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id": "1", "status": "ok", "duration": 1500}`)
+	})
+
+	ctx := context.Background()
+	checkIn, _, err := client.Monitors.CreateCheckIn(ctx, "the-interstellar-jurisdiction", "hourly-sync", &MonitorCheckInParams{
+		Status:      String(MonitorCheckInStatusInProgress),
+		Environment: String("production"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &MonitorCheckIn{ID: String("1"), Status: String("in_progress")}, checkIn)
+
+	checkIn, _, err = client.Monitors.UpdateCheckIn(ctx, "the-interstellar-jurisdiction", "hourly-sync", *checkIn.ID, &MonitorCheckInParams{
+		Status:   String(MonitorCheckInStatusOK),
+		Duration: Int(1500),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &MonitorCheckIn{ID: String("1"), Status: String("ok"), Duration: Int(1500)}, checkIn)
+}
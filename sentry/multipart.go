@@ -0,0 +1,59 @@
+package sentry
+
+import (
+	"io"
+	"mime/multipart"
+)
+
+// MultipartForm is a request body sent as multipart/form-data.
+// Pass it as the body of Client.NewRequest.
+type MultipartForm struct {
+	Fields []*MultipartField
+	Files  []*MultipartFile
+}
+
+// MultipartField is a form field of a MultipartForm.
+type MultipartField struct {
+	Name  string
+	Value string
+}
+
+// MultipartFile is a file of a MultipartForm.
+type MultipartFile struct {
+	FieldName string
+	FileName  string
+	Content   io.Reader
+}
+
+// AddField appends a form field.
+func (f *MultipartForm) AddField(name, value string) {
+	f.Fields = append(f.Fields, &MultipartField{Name: name, Value: value})
+}
+
+// AddFile appends a file.
+func (f *MultipartForm) AddFile(fieldName, fileName string, content io.Reader) {
+	f.Files = append(f.Files, &MultipartFile{FieldName: fieldName, FileName: fileName, Content: content})
+}
+
+// encode writes the form to w and returns its content type.
+func (f *MultipartForm) encode(w io.Writer) (string, error) {
+	mw := multipart.NewWriter(w)
+	for _, field := range f.Fields {
+		if err := mw.WriteField(field.Name, field.Value); err != nil {
+			return "", err
+		}
+	}
+	for _, file := range f.Files {
+		part, err := mw.CreateFormFile(file.FieldName, file.FileName)
+		if err != nil {
+			return "", err
+		}
+		if _, err := io.Copy(part, file.Content); err != nil {
+			return "", err
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return "", err
+	}
+	return mw.FormDataContentType(), nil
+}
@@ -52,6 +52,18 @@ func (s *OrganizationCodeMappingsService) List(ctx context.Context, organization
 	return integrations, resp, nil
 }
 
+// ListAll returns all organization code mappings, following pagination.
+func (s *OrganizationCodeMappingsService) ListAll(ctx context.Context, organizationSlug string, params *ListOrganizationCodeMappingsParams) ([]*OrganizationCodeMapping, *Response, error) {
+	p := ListOrganizationCodeMappingsParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewPager(func(ctx context.Context, cursor string) ([]*OrganizationCodeMapping, *Response, error) {
+		p.Cursor = cursor
+		return s.List(ctx, organizationSlug, &p)
+	}).All(ctx)
+}
+
 // https://github.com/getsentry/sentry/blob/22.7.0/src/sentry/api/endpoints/organization_code_mappings.py#L26-L35
 type CreateOrganizationCodeMappingParams struct {
 	DefaultBranch string `json:"defaultBranch"`
@@ -73,6 +73,18 @@ func (s *OrganizationIntegrationsService) List(ctx context.Context, organization
 	return integrations, resp, nil
 }
 
+// ListAll returns all organization integrations, following pagination.
+func (s *OrganizationIntegrationsService) ListAll(ctx context.Context, organizationSlug string, params *ListOrganizationIntegrationsParams) ([]*OrganizationIntegration, *Response, error) {
+	p := ListOrganizationIntegrationsParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewPager(func(ctx context.Context, cursor string) ([]*OrganizationIntegration, *Response, error) {
+		p.Cursor = cursor
+		return s.List(ctx, organizationSlug, &p)
+	}).All(ctx)
+}
+
 // Get organization integration details.
 func (s *OrganizationIntegrationsService) Get(ctx context.Context, organizationSlug string, integrationID string) (*OrganizationIntegration, *Response, error) {
 	u := fmt.Sprintf("0/organizations/%v/integrations/%v/", organizationSlug, integrationID)
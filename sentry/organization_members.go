@@ -60,6 +60,13 @@ func (s *OrganizationMembersService) List(ctx context.Context, organizationSlug
 	return members, resp, nil
 }
 
+// ListAll returns all organization members, following pagination.
+func (s *OrganizationMembersService) ListAll(ctx context.Context, organizationSlug string) ([]*OrganizationMember, *Response, error) {
+	return NewPager(func(ctx context.Context, cursor string) ([]*OrganizationMember, *Response, error) {
+		return s.List(ctx, organizationSlug, &ListCursorParams{Cursor: cursor})
+	}).All(ctx)
+}
+
 func (s *OrganizationMembersService) Get(ctx context.Context, organizationSlug string, memberID string) (*OrganizationMember, *Response, error) {
 	u := fmt.Sprintf("0/organizations/%v/members/%v/", organizationSlug, memberID)
 	req, err := s.client.NewRequest("GET", u, nil)
@@ -35,3 +35,15 @@ func (s *OrganizationProjectsService) List(ctx context.Context, organizationSlug
 	}
 	return projects, resp, nil
 }
+
+// ListAll returns all projects of an organization, following pagination.
+func (s *OrganizationProjectsService) ListAll(ctx context.Context, organizationSlug string, params *ListOrganizationProjectsParams) ([]*Project, *Response, error) {
+	p := ListOrganizationProjectsParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewPager(func(ctx context.Context, cursor string) ([]*Project, *Response, error) {
+		p.Cursor = cursor
+		return s.List(ctx, organizationSlug, &p)
+	}).All(ctx)
+}
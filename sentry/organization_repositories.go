@@ -62,6 +62,18 @@ func (s *OrganizationRepositoriesService) List(ctx context.Context, organization
 	return repos, resp, nil
 }
 
+// ListAll returns all organization repositories, following pagination.
+func (s *OrganizationRepositoriesService) ListAll(ctx context.Context, organizationSlug string, params *ListOrganizationRepositoriesParams) ([]*OrganizationRepository, *Response, error) {
+	p := ListOrganizationRepositoriesParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewPager(func(ctx context.Context, cursor string) ([]*OrganizationRepository, *Response, error) {
+		p.Cursor = cursor
+		return s.List(ctx, organizationSlug, &p)
+	}).All(ctx)
+}
+
 // Fields are different for different providers
 type CreateOrganizationRepositoryParams map[string]interface{}
 
@@ -97,6 +97,13 @@ func (s *OrganizationsService) List(ctx context.Context, params *ListCursorParam
 	return orgs, resp, nil
 }
 
+// ListAll returns all organizations available to the authenticated session, following pagination.
+func (s *OrganizationsService) ListAll(ctx context.Context) ([]*Organization, *Response, error) {
+	return NewPager(func(ctx context.Context, cursor string) ([]*Organization, *Response, error) {
+		return s.List(ctx, &ListCursorParams{Cursor: cursor})
+	}).All(ctx)
+}
+
 // Get a Sentry organization.
 // https://docs.sentry.io/api/organizations/retrieve-an-organization/
 func (s *OrganizationsService) Get(ctx context.Context, slug string) (*Organization, *Response, error) {
@@ -0,0 +1,122 @@
+package sentry
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrPaginationLimit is returned by Pager.All when MaxPages or MaxItems is
+// reached before all pages have been fetched.
+var ErrPaginationLimit = errors.New("sentry: pagination limit reached")
+
+// PageFunc fetches the page of results starting at cursor.
+// An empty cursor fetches the first page.
+type PageFunc[T any] func(ctx context.Context, cursor string) ([]T, *Response, error)
+
+// Pager walks the pages of a cursor-paginated List endpoint, forward with
+// Next or backward with Prev.
+//
+//	pager := sentry.NewPager(func(ctx context.Context, cursor string) ([]*sentry.Team, *sentry.Response, error) {
+//		return client.Teams.List(ctx, "my-org", &sentry.ListCursorParams{Cursor: cursor})
+//	})
+//	for pager.HasNext() {
+//		teams, _, err := pager.Next(ctx)
+//		...
+//	}
+type Pager[T any] struct {
+	// The maximum number of pages to fetch. Zero means no limit.
+	MaxPages int
+
+	// The maximum number of items to return from All. Zero means no limit.
+	MaxItems int
+
+	fetch      PageFunc[T]
+	started    bool
+	nextCursor string
+	prevCursor string
+	hasNext    bool
+	hasPrev    bool
+	pages      int
+}
+
+// NewPager returns a Pager that fetches pages using fetch, starting with the first page.
+func NewPager[T any](fetch PageFunc[T]) *Pager[T] {
+	return NewPagerAt(fetch, "")
+}
+
+// NewPagerAt returns a Pager whose first call to Next fetches the page at cursor.
+func NewPagerAt[T any](fetch PageFunc[T], cursor string) *Pager[T] {
+	return &Pager[T]{fetch: fetch, nextCursor: cursor}
+}
+
+func (p *Pager[T]) belowMaxPages() bool {
+	return p.MaxPages <= 0 || p.pages < p.MaxPages
+}
+
+// HasNext reports whether there are more pages to fetch with Next.
+func (p *Pager[T]) HasNext() bool {
+	return (!p.started || p.hasNext) && p.belowMaxPages()
+}
+
+// HasPrev reports whether there are previous pages to fetch with Prev.
+func (p *Pager[T]) HasPrev() bool {
+	return p.started && p.hasPrev && p.belowMaxPages()
+}
+
+// Next fetches the next page of results.
+// It returns nil results once all pages have been fetched.
+func (p *Pager[T]) Next(ctx context.Context) ([]T, *Response, error) {
+	if !p.HasNext() {
+		return nil, nil, nil
+	}
+	return p.fetchPage(ctx, p.nextCursor)
+}
+
+// Prev fetches the page of results before the last fetched page.
+// It returns nil results if there is no previous page.
+func (p *Pager[T]) Prev(ctx context.Context) ([]T, *Response, error) {
+	if !p.HasPrev() {
+		return nil, nil, nil
+	}
+	return p.fetchPage(ctx, p.prevCursor)
+}
+
+func (p *Pager[T]) fetchPage(ctx context.Context, cursor string) ([]T, *Response, error) {
+	items, resp, err := p.fetch(ctx, cursor)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	p.started = true
+	p.pages++
+	p.nextCursor, p.hasNext = resp.NextCursor, resp.HasNext
+	p.prevCursor, p.hasPrev = resp.PrevCursor, resp.HasPrev
+	return items, resp, nil
+}
+
+// All fetches the remaining pages and returns their results along with the
+// last response. If MaxPages or MaxItems is reached while more results are
+// available, the results collected so far are returned with ErrPaginationLimit.
+func (p *Pager[T]) All(ctx context.Context) ([]T, *Response, error) {
+	all := []T{}
+	var lastResp *Response
+	for p.HasNext() {
+		items, resp, err := p.Next(ctx)
+		if err != nil {
+			return all, resp, err
+		}
+		lastResp = resp
+		all = append(all, items...)
+
+		if p.MaxItems > 0 && len(all) >= p.MaxItems {
+			if len(all) > p.MaxItems || p.hasNext {
+				return all[:p.MaxItems], lastResp, ErrPaginationLimit
+			}
+			return all, lastResp, nil
+		}
+	}
+	if p.hasNext {
+		return all, lastResp, ErrPaginationLimit
+	}
+	return all, lastResp, nil
+}
@@ -0,0 +1,196 @@
+package sentry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakePages returns a PageFunc serving the given pages, using the page index as cursor.
+func fakePages(pages [][]int) PageFunc[int] {
+	return func(ctx context.Context, cursor string) ([]int, *Response, error) {
+		i := 0
+		if cursor != "" {
+			fmt.Sscanf(cursor, "%d", &i)
+		}
+		resp := &Response{Response: &http.Response{StatusCode: http.StatusOK}}
+		if i+1 < len(pages) {
+			resp.NextCursor = fmt.Sprintf("%d", i+1)
+			resp.HasNext = true
+			resp.Cursor = resp.NextCursor
+		}
+		if i > 0 {
+			resp.PrevCursor = fmt.Sprintf("%d", i-1)
+			resp.HasPrev = true
+		}
+		return pages[i], resp, nil
+	}
+}
+
+func TestPager_Next(t *testing.T) {
+	pager := NewPager(fakePages([][]int{{1, 2}, {3}}))
+	ctx := context.Background()
+
+	assert.True(t, pager.HasNext())
+	items, _, err := pager.Next(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, items)
+
+	assert.True(t, pager.HasNext())
+	items, _, err = pager.Next(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{3}, items)
+
+	assert.False(t, pager.HasNext())
+	items, resp, err := pager.Next(ctx)
+	assert.NoError(t, err)
+	assert.Nil(t, items)
+	assert.Nil(t, resp)
+}
+
+func TestPager_Prev(t *testing.T) {
+	pager := NewPagerAt(fakePages([][]int{{1, 2}, {3, 4}, {5}}), "2")
+	ctx := context.Background()
+
+	assert.False(t, pager.HasPrev())
+	items, _, err := pager.Next(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{5}, items)
+	assert.False(t, pager.HasNext())
+
+	assert.True(t, pager.HasPrev())
+	items, _, err = pager.Prev(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{3, 4}, items)
+
+	assert.True(t, pager.HasPrev())
+	items, _, err = pager.Prev(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, items)
+
+	assert.False(t, pager.HasPrev())
+	items, resp, err := pager.Prev(ctx)
+	assert.NoError(t, err)
+	assert.Nil(t, items)
+	assert.Nil(t, resp)
+
+	assert.True(t, pager.HasNext())
+	items, _, err = pager.Next(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{3, 4}, items)
+}
+
+func TestPager_All(t *testing.T) {
+	pager := NewPager(fakePages([][]int{{1, 2}, {3, 4}, {5}}))
+
+	items, resp, err := pager.All(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, items)
+	assert.Equal(t, "", resp.Cursor)
+}
+
+func TestPager_All_maxPages(t *testing.T) {
+	pager := NewPager(fakePages([][]int{{1, 2}, {3, 4}, {5}}))
+	pager.MaxPages = 2
+
+	items, resp, err := pager.All(context.Background())
+	assert.ErrorIs(t, err, ErrPaginationLimit)
+	assert.Equal(t, []int{1, 2, 3, 4}, items)
+	assert.Equal(t, "2", resp.NextCursor)
+}
+
+func TestPager_All_maxItems(t *testing.T) {
+	pager := NewPager(fakePages([][]int{{1, 2}, {3, 4}, {5}}))
+	pager.MaxItems = 3
+
+	items, _, err := pager.All(context.Background())
+	assert.ErrorIs(t, err, ErrPaginationLimit)
+	assert.Equal(t, []int{1, 2, 3}, items)
+}
+
+func TestPager_All_maxItemsExact(t *testing.T) {
+	pager := NewPager(fakePages([][]int{{1, 2}, {3}}))
+	pager.MaxItems = 3
+
+	items, _, err := pager.All(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, items)
+}
+
+func TestPager_All_error(t *testing.T) {
+	fetchErr := errors.New("boom")
+	pager := NewPager(func(ctx context.Context, cursor string) ([]int, *Response, error) {
+		if cursor == "" {
+			return []int{1}, &Response{Cursor: "next", NextCursor: "next", HasNext: true}, nil
+		}
+		return nil, nil, fetchErr
+	})
+
+	items, _, err := pager.All(context.Background())
+	assert.ErrorIs(t, err, fetchErr)
+	assert.Equal(t, []int{1}, items)
+}
+
+func TestTeamsService_ListAll(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/teams/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		u := serverURL + "/api/0/organizations/the-interstellar-jurisdiction/teams/"
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			w.Header().Set("Link", fmt.Sprintf(`<%s?&cursor=100:-1:1>; rel="previous"; results="false"; cursor="100:-1:1", <%s?&cursor=100:1:0>; rel="next"; results="true"; cursor="100:1:0"`, u, u))
+			fmt.Fprint(w, `[{"id": "3", "slug": "ancient-gabelers"}]`)
+		case "100:1:0":
+			w.Header().Set("Link", fmt.Sprintf(`<%s?&cursor=100:0:1>; rel="previous"; results="true"; cursor="100:0:1", <%s?&cursor=100:2:0>; rel="next"; results="false"; cursor="100:2:0"`, u, u))
+			fmt.Fprint(w, `[{"id": "2", "slug": "powerful-abolitionist"}]`)
+		default:
+			t.Errorf("unexpected cursor %q", r.URL.Query().Get("cursor"))
+		}
+	})
+
+	ctx := context.Background()
+	teams, _, err := client.Teams.ListAll(ctx, "the-interstellar-jurisdiction")
+	assert.NoError(t, err)
+
+	expected := []*Team{
+		{ID: String("3"), Slug: String("ancient-gabelers")},
+		{ID: String("2"), Slug: String("powerful-abolitionist")},
+	}
+	assert.Equal(t, expected, teams)
+}
+
+func TestProjectKeysService_ListAll(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/keys/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assert.Equal(t, "active", r.URL.Query().Get("status"))
+		w.Header().Set("Content-Type", "application/json")
+		u := serverURL + "/api/0/projects/the-interstellar-jurisdiction/pump-station/keys/"
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			w.Header().Set("Link", fmt.Sprintf(`<%s?&cursor=100:1:0>; rel="next"; results="true"; cursor="100:1:0"`, u))
+			fmt.Fprint(w, `[{"id": "key1"}]`)
+		case "100:1:0":
+			w.Header().Set("Link", fmt.Sprintf(`<%s?&cursor=100:2:0>; rel="next"; results="false"; cursor="100:2:0"`, u))
+			fmt.Fprint(w, `[{"id": "key2"}]`)
+		}
+	})
+
+	ctx := context.Background()
+	keys, _, err := client.ProjectKeys.ListAll(ctx, "the-interstellar-jurisdiction", "pump-station", &ListProjectKeysParams{
+		Status: String("active"),
+	})
+	assert.NoError(t, err)
+	assert.Len(t, keys, 2)
+	assert.Equal(t, "key1", keys[0].ID)
+	assert.Equal(t, "key2", keys[1].ID)
+}
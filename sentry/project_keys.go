@@ -82,6 +82,18 @@ func (s *ProjectKeysService) List(ctx context.Context, organizationSlug string,
 	return projectKeys, resp, nil
 }
 
+// ListAll returns all client keys bound to a project, following pagination.
+func (s *ProjectKeysService) ListAll(ctx context.Context, organizationSlug string, projectSlug string, params *ListProjectKeysParams) ([]*ProjectKey, *Response, error) {
+	p := ListProjectKeysParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewPager(func(ctx context.Context, cursor string) ([]*ProjectKey, *Response, error) {
+		p.Cursor = cursor
+		return s.List(ctx, organizationSlug, projectSlug, &p)
+	}).All(ctx)
+}
+
 // Get details of a client key.
 // https://docs.sentry.io/api/projects/retrieve-a-client-key/
 func (s *ProjectKeysService) Get(ctx context.Context, organizationSlug string, projectSlug string, id string) (*ProjectKey, *Response, error) {
@@ -118,6 +118,18 @@ func (s *ProjectsService) List(ctx context.Context, params *ListProjectsParams)
 	return projects, resp, nil
 }
 
+// ListAll returns all projects available, following pagination.
+func (s *ProjectsService) ListAll(ctx context.Context, params *ListProjectsParams) ([]*Project, *Response, error) {
+	p := ListProjectsParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewPager(func(ctx context.Context, cursor string) ([]*Project, *Response, error) {
+		p.Cursor = cursor
+		return s.List(ctx, &p)
+	}).All(ctx)
+}
+
 // Get details on an individual project.
 // https://docs.sentry.io/api/projects/retrieve-a-project/
 func (s *ProjectsService) Get(ctx context.Context, organizationSlug string, slug string) (*Project, *Response, error) {
@@ -0,0 +1,283 @@
+package sentry
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// rateLimitBucketIdleTimeout is how long a bucket with nothing in flight and
+// no pending window is kept before being evicted.
+const rateLimitBucketIdleTimeout = 10 * time.Minute
+
+// RateLimitState is the client-side view of the rate limits of one endpoint
+// bucket, as learned from the X-Sentry-Rate-Limit-* response headers.
+type RateLimitState struct {
+	// The maximum number of requests allowed within the window, or 0 if unknown.
+	Limit int
+
+	// The number of requests left within the current window.
+	Remaining int
+
+	// The time when the current window resets.
+	Reset time.Time
+
+	// The maximum number of concurrent requests, or 0 if unknown.
+	ConcurrentLimit int
+
+	// The number of requests currently in flight.
+	InFlight int
+}
+
+// rateLimiter gates requests per endpoint bucket. It limits the number of
+// in-flight requests to the learned concurrent limit and holds requests back
+// once the current window's budget is exhausted, until the window resets.
+type rateLimiter struct {
+	mu      sync.Mutex
+	buckets map[string]*rateLimitBucket
+
+	// Returns the bucket a request is accounted to. Defaults to
+	// rateLimitBucketKey.
+	key func(req *http.Request) string
+
+	// The last time idle buckets were evicted.
+	lastEviction time.Time
+}
+
+type rateLimitBucket struct {
+	RateLimitState
+
+	// Closed and replaced whenever the bucket changes, to wake up waiters.
+	changed chan struct{}
+
+	// The last time a request was accounted to the bucket.
+	lastUsed time.Time
+}
+
+// WithRateLimiter enables the client-side rate limiter, which keeps requests
+// within the limits advertised by Sentry instead of running into 429s.
+// Use Client.RateLimitState to inspect the learned limits.
+func WithRateLimiter() ClientOption {
+	return func(c *Client) error {
+		c.limiter = newRateLimiter()
+		return nil
+	}
+}
+
+// WithRateLimitBucketKey enables the client-side rate limiter and sets the
+// function returning the bucket a request is accounted to. Requests with the
+// same key share the same limits.
+func WithRateLimitBucketKey(key func(req *http.Request) string) ClientOption {
+	return func(c *Client) error {
+		if c.limiter == nil {
+			c.limiter = newRateLimiter()
+		}
+		c.limiter.key = key
+		return nil
+	}
+}
+
+func newRateLimiter() *rateLimiter {
+	return &rateLimiter{buckets: make(map[string]*rateLimitBucket)}
+}
+
+func (l *rateLimiter) bucketKey(req *http.Request) string {
+	if l.key != nil {
+		return l.key(req)
+	}
+	return rateLimitBucketKey(req)
+}
+
+// rateLimitBucketKey returns the bucket a request is accounted to.
+// Sentry applies rate limits per endpoint and HTTP method, so the path is
+// reduced to its endpoint template, e.g. "GET /api/0/projects/*/*/rules/*/".
+func rateLimitBucketKey(req *http.Request) string {
+	return req.Method + " " + rateLimitEndpoint(req.URL.EscapedPath())
+}
+
+// Number of path parameters following the top-level resources of the API.
+var rateLimitScopeParams = map[string]int{
+	"organizations": 1,
+	"projects":      2,
+	"teams":         2,
+}
+
+// Path segments that name a nested resource or an action rather than an
+// identifier, although they follow a resource.
+var rateLimitLiteralSegments = map[string]bool{
+	"assemble":      true,
+	"configuration": true,
+	"difs":          true,
+	"dsyms":         true,
+	"saved":         true,
+	"actions":       true,
+	"widgets":       true,
+}
+
+// rateLimitEndpoint replaces the identifiers of an API path with "*".
+// After the scope of the request, Sentry paths alternate between resources
+// and identifiers, e.g. 0/projects/{org}/{project}/rules/{id}/snooze/.
+func rateLimitEndpoint(path string) string {
+	segments := strings.Split(path, "/")
+	start := -1
+	for i, segment := range segments {
+		if segment == "0" {
+			start = i + 1
+			break
+		}
+	}
+	if start < 0 || start >= len(segments) {
+		return path
+	}
+
+	i := start + 1
+	for n := rateLimitScopeParams[segments[start]]; n > 0 && i < len(segments) && segments[i] != ""; n-- {
+		segments[i] = "*"
+		i++
+	}
+	identifier := false
+	for ; i < len(segments); i++ {
+		if segments[i] == "" {
+			continue
+		}
+		if rateLimitLiteralSegments[segments[i]] {
+			identifier = true
+			continue
+		}
+		if identifier {
+			segments[i] = "*"
+		}
+		identifier = !identifier
+	}
+	return strings.Join(segments, "/")
+}
+
+func (l *rateLimiter) bucket(key string) *rateLimitBucket {
+	now := time.Now()
+	if now.Sub(l.lastEviction) >= time.Minute {
+		l.evictIdle(now)
+	}
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &rateLimitBucket{changed: make(chan struct{})}
+		l.buckets[key] = b
+	}
+	b.lastUsed = now
+	return b
+}
+
+// evictIdle removes the buckets that have nothing in flight, no window to
+// wait for and were not used recently.
+func (l *rateLimiter) evictIdle(now time.Time) {
+	l.lastEviction = now
+	for key, b := range l.buckets {
+		if b.InFlight == 0 && !b.Reset.After(now) && now.Sub(b.lastUsed) >= rateLimitBucketIdleTimeout {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// acquire blocks until a request may be sent to the bucket.
+func (l *rateLimiter) acquire(ctx context.Context, key string) error {
+	for {
+		l.mu.Lock()
+		b := l.bucket(key)
+		now := time.Now()
+		if b.Limit > 0 && !b.Reset.IsZero() && !now.Before(b.Reset) {
+			// A new window has started.
+			b.Remaining = b.Limit
+			b.Reset = time.Time{}
+		}
+
+		concurrentOK := b.ConcurrentLimit == 0 || b.InFlight < b.ConcurrentLimit
+		windowOK := b.Limit == 0 || b.Remaining > 0 || b.Reset.IsZero()
+		if concurrentOK && windowOK {
+			b.InFlight++
+			if b.Remaining > 0 {
+				b.Remaining--
+			}
+			l.mu.Unlock()
+			return nil
+		}
+
+		changed := b.changed
+		var timer *time.Timer
+		var wait <-chan time.Time
+		if !windowOK {
+			timer = time.NewTimer(b.Reset.Sub(now))
+			wait = timer.C
+		}
+		l.mu.Unlock()
+
+		var err error
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+		case <-changed:
+		case <-wait:
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// release marks a request to the bucket as finished and learns the limits
+// reported by its response, if any.
+func (l *rateLimiter) release(key string, resp *Response) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b := l.bucket(key)
+	b.InFlight--
+
+	if resp != nil {
+		rate := resp.Rate
+		if rate.ConcurrentLimit > 0 {
+			// Other callers may share the concurrent budget, so our share is
+			// what we had in flight plus what Sentry reports as remaining.
+			share := b.InFlight + 1 + rate.ConcurrentRemaining
+			if share > rate.ConcurrentLimit {
+				share = rate.ConcurrentLimit
+			}
+			b.ConcurrentLimit = share
+		}
+		if rate.Limit > 0 {
+			if b.Limit != rate.Limit || !b.Reset.Equal(rate.Reset) || rate.Remaining < b.Remaining {
+				b.Remaining = rate.Remaining
+			}
+			b.Limit = rate.Limit
+			b.Reset = rate.Reset
+		}
+	}
+
+	close(b.changed)
+	b.changed = make(chan struct{})
+}
+
+func (l *rateLimiter) state() map[string]RateLimitState {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	state := make(map[string]RateLimitState, len(l.buckets))
+	for key, b := range l.buckets {
+		state[key] = b.RateLimitState
+	}
+	return state
+}
+
+// RateLimitState returns the current state of the client-side rate limiter,
+// keyed by endpoint bucket, e.g. "GET /api/0/projects/*/*/rules/". It returns
+// nil if the rate limiter is not enabled.
+func (c *Client) RateLimitState() map[string]RateLimitState {
+	if c.limiter == nil {
+		return nil
+	}
+	return c.limiter.state()
+}
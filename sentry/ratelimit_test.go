@@ -0,0 +1,233 @@
+package sentry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter_concurrentLimit(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	assert.NoError(t, WithRateLimiter()(client))
+
+	var inFlight, maxInFlight int32
+	mux.HandleFunc("/api/0/organizations/", func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		w.Header().Set(headerRateConcurrentLimit, "2")
+		w.Header().Set(headerRateConcurrentRemaining, "1")
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[]`)
+	})
+
+	ctx := context.Background()
+
+	// The first request teaches the limiter the concurrent limit.
+	_, _, err := client.Organizations.List(ctx, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, RateLimitState{ConcurrentLimit: 2}, client.RateLimitState()["GET /api/0/organizations/"])
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _, err := client.Organizations.List(ctx, nil)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxInFlight), int32(2))
+	assert.Equal(t, 0, client.RateLimitState()["GET /api/0/organizations/"].InFlight)
+}
+
+func TestRateLimiter_windowExhausted(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	assert.NoError(t, WithRateLimiter()(client))
+
+	reset := time.Now().Add(time.Hour).Truncate(time.Second).UTC()
+	requests := 0
+	mux.HandleFunc("/api/0/organizations/", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set(headerRateLimit, "40")
+		w.Header().Set(headerRateRemaining, "0")
+		w.Header().Set(headerRateReset, strconv.FormatInt(reset.Unix(), 10))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[]`)
+	})
+
+	_, _, err := client.Organizations.List(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, RateLimitState{Limit: 40, Remaining: 0, Reset: reset}, client.RateLimitState()["GET /api/0/organizations/"])
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, _, err = client.Organizations.List(ctx, nil)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 1, requests)
+
+	// Other endpoints are accounted to their own bucket.
+	mux.HandleFunc("/api/0/projects/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[]`)
+	})
+	_, _, err = client.Projects.List(context.Background(), nil)
+	assert.NoError(t, err)
+}
+
+func TestRateLimiter_windowReset(t *testing.T) {
+	l := newRateLimiter()
+	key := "GET /api/0/organizations/"
+	b := l.bucket(key)
+	b.Limit = 40
+	b.Remaining = 0
+	b.Reset = time.Now().Add(-time.Second)
+
+	assert.NoError(t, l.acquire(context.Background(), key))
+	assert.Equal(t, RateLimitState{Limit: 40, Remaining: 39, InFlight: 1}, l.state()[key])
+}
+
+func TestClient_RateLimitState_disabled(t *testing.T) {
+	client := NewClient(nil)
+	assert.Nil(t, client.RateLimitState())
+}
+
+func TestRateLimiter_sharedEndpoint(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	assert.NoError(t, WithRateLimiter()(client))
+
+	var inFlight, maxInFlight int32
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/issues/", func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		w.Header().Set(headerRateConcurrentLimit, "2")
+		w.Header().Set(headerRateConcurrentRemaining, "1")
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{}`)
+	})
+
+	ctx := context.Background()
+	key := "GET /api/0/organizations/*/issues/*/"
+
+	// The limit learned from one issue applies to the others.
+	_, _, err := client.Issues.Get(ctx, "the-interstellar-jurisdiction", "1")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, client.RateLimitState()[key].ConcurrentLimit)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			_, _, err := client.Issues.Get(ctx, "the-interstellar-jurisdiction", id)
+			assert.NoError(t, err)
+		}(strconv.Itoa(1 + i%2))
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxInFlight), int32(2))
+	assert.Len(t, client.RateLimitState(), 1)
+	assert.Equal(t, 0, client.RateLimitState()[key].InFlight)
+}
+
+func TestRateLimitEndpoint(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected string
+	}{
+		{"/api/0/organizations/", "/api/0/organizations/"},
+		{"/api/0/organizations/acme/", "/api/0/organizations/*/"},
+		{"/api/0/organizations/acme/issues/123/tags/environment/values/", "/api/0/organizations/*/issues/*/tags/*/values/"},
+		{"/api/0/organizations/acme/discover/saved/42/visit/", "/api/0/organizations/*/discover/saved/*/visit/"},
+		{"/api/0/organizations/acme/dashboards/widgets/", "/api/0/organizations/*/dashboards/widgets/"},
+		{"/api/0/organizations/acme/notifications/actions/7/", "/api/0/organizations/*/notifications/actions/*/"},
+		{"/api/0/projects/acme/pump-station/rules/", "/api/0/projects/*/*/rules/"},
+		{"/api/0/projects/acme/pump-station/rules/12345/snooze/", "/api/0/projects/*/*/rules/*/snooze/"},
+		{"/api/0/projects/acme/pump-station/rules/configuration/", "/api/0/projects/*/*/rules/configuration/"},
+		{"/api/0/projects/acme/pump-station/files/difs/assemble/", "/api/0/projects/*/*/files/difs/assemble/"},
+		{"/api/0/teams/acme/powerful-abolitionist/projects/", "/api/0/teams/*/*/projects/"},
+		{"/other/path/", "/other/path/"},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected, rateLimitEndpoint(test.path), test.path)
+	}
+}
+
+func TestRateLimitBucketKey_escapedPath(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	for _, version := range []string{"frontend@1.0.0", "frontend@1.0/abc", "a/b/c"} {
+		u := fmt.Sprintf("0/organizations/acme/releases/%v/deploys/", url.PathEscape(version))
+		req, err := client.NewRequest("GET", u, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "GET /api/0/organizations/*/releases/*/deploys/", rateLimitBucketKey(req), version)
+	}
+}
+
+func TestRateLimiter_evictIdle(t *testing.T) {
+	l := newRateLimiter()
+	now := time.Now()
+
+	idle := l.bucket("GET /api/0/organizations/")
+	idle.lastUsed = now.Add(-rateLimitBucketIdleTimeout)
+	waiting := l.bucket("GET /api/0/projects/")
+	waiting.lastUsed = now.Add(-rateLimitBucketIdleTimeout)
+	waiting.Reset = now.Add(time.Minute)
+	busy := l.bucket("GET /api/0/teams/*/*/")
+	busy.lastUsed = now.Add(-rateLimitBucketIdleTimeout)
+	busy.InFlight = 1
+	l.bucket("GET /api/0/organizations/*/")
+
+	l.evictIdle(now)
+	assert.Len(t, l.buckets, 3)
+	assert.NotContains(t, l.buckets, "GET /api/0/organizations/")
+}
+
+func TestWithRateLimitBucketKey(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	assert.NoError(t, WithRateLimitBucketKey(func(req *http.Request) string {
+		return "all"
+	})(client))
+
+	mux.HandleFunc("/api/0/organizations/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateConcurrentLimit, "3")
+		w.Header().Set(headerRateConcurrentRemaining, "2")
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[]`)
+	})
+
+	_, _, err := client.Organizations.List(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]RateLimitState{"all": {ConcurrentLimit: 3}}, client.RateLimitState())
+}
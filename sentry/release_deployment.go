@@ -3,9 +3,12 @@ package sentry
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"time"
 )
 
+// ReleaseDeploymentsService provides methods for accessing Sentry release deploy API endpoints.
+// https://docs.sentry.io/api/releases/
 type ReleaseDeploymentsService service
 
 type ReleaseDeployment struct {
@@ -18,53 +21,93 @@ type ReleaseDeployment struct {
 	DateFinished *time.Time `json:"dateFinished,omitempty"`
 }
 
-// Get a Release Deploy for a project.
-func (s *ReleaseDeploymentsService) Get(ctx context.Context, organizationSlug string, version string, deployID string) (*ReleaseDeployment, *Response, error) {
+// List the deploys of a release.
+// https://docs.sentry.io/api/releases/list-a-releases-deploys/
+func (s *ReleaseDeploymentsService) List(ctx context.Context, organizationSlug string, version string, params *ListCursorParams) ([]*ReleaseDeployment, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/releases/%v/deploys/", organizationSlug, url.PathEscape(version))
+	u, err := addQuery(u, params)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	lastCursor := ""
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	// Search for the deployment ID by using the list endpoint. When we have
-	// found the first match return immediately
-	for {
-		params := ListCursorParams{
-			Cursor: lastCursor,
-		}
+	deployments := []*ReleaseDeployment{}
+	resp, err := s.client.Do(ctx, req, &deployments)
+	if err != nil {
+		return nil, resp, err
+	}
+	return deployments, resp, nil
+}
 
-		u := fmt.Sprintf("0/organizations/%v/releases/%s/deploys/", organizationSlug, version)
-		u, err := addQuery(u, params)
-		if err != nil {
-			return nil, nil, err
-		}
+// ListAll returns all deploys of a release, following pagination.
+func (s *ReleaseDeploymentsService) ListAll(ctx context.Context, organizationSlug string, version string) ([]*ReleaseDeployment, *Response, error) {
+	return NewPager(func(ctx context.Context, cursor string) ([]*ReleaseDeployment, *Response, error) {
+		return s.List(ctx, organizationSlug, version, &ListCursorParams{Cursor: cursor})
+	}).All(ctx)
+}
 
-		req, err := s.client.NewRequest("GET", u, nil)
-		if err != nil {
-			return nil, nil, err
-		}
+// FindReleaseDeploymentParams are the parameters for ReleaseDeploymentsService.Find.
+// Deploys match if they satisfy all of the set fields.
+type FindReleaseDeploymentParams struct {
+	ID          *string
+	Environment *string
+}
+
+func (p *FindReleaseDeploymentParams) matches(d *ReleaseDeployment) bool {
+	if p.ID != nil && d.ID != *p.ID {
+		return false
+	}
+	if p.Environment != nil && d.Environment != *p.Environment {
+		return false
+	}
+	return true
+}
+
+// Find returns the first deploy of a release matching params, in the order
+// returned by the API. Pages are fetched only until a match is found.
+// If no deploy matches, a nil deploy is returned without an error.
+func (s *ReleaseDeploymentsService) Find(ctx context.Context, organizationSlug string, version string, params *FindReleaseDeploymentParams) (*ReleaseDeployment, *Response, error) {
+	if params == nil {
+		params = &FindReleaseDeploymentParams{}
+	}
+
+	pager := NewPager(func(ctx context.Context, cursor string) ([]*ReleaseDeployment, *Response, error) {
+		return s.List(ctx, organizationSlug, version, &ListCursorParams{Cursor: cursor})
+	})
 
-		deployments := new([]ReleaseDeployment)
-		resp, err := s.client.Do(ctx, req, deployments)
+	var lastResp *Response
+	for pager.HasNext() {
+		deployments, resp, err := pager.Next(ctx)
 		if err != nil {
 			return nil, resp, err
 		}
+		lastResp = resp
 
-		for i := range *deployments {
-			d := (*deployments)[i]
-			if d.ID == deployID {
-				return &d, resp, nil
+		for _, d := range deployments {
+			if params.matches(d) {
+				return d, resp, nil
 			}
 		}
-
-		// No matches in the current page and no further pages to check
-		if resp.Cursor == "" {
-			return nil, resp, nil
-		}
-		lastCursor = resp.Cursor
 	}
+	return nil, lastResp, nil
+}
+
+// Get a Release Deploy for a project.
+// The deploys endpoint has no detail route, so this searches the deploys of
+// the release and returns a nil deploy if none has the given ID.
+func (s *ReleaseDeploymentsService) Get(ctx context.Context, organizationSlug string, version string, deployID string) (*ReleaseDeployment, *Response, error) {
+	return s.Find(ctx, organizationSlug, version, &FindReleaseDeploymentParams{
+		ID: &deployID,
+	})
 }
 
 // Create a new Release Deploy to a project.
 func (s *ReleaseDeploymentsService) Create(ctx context.Context, organizationSlug string, version string, params *ReleaseDeployment) (*ReleaseDeployment, *Response, error) {
-	u := fmt.Sprintf("0/organizations/%v/releases/%s/deploys/", organizationSlug, version)
+	u := fmt.Sprintf("0/organizations/%v/releases/%v/deploys/", organizationSlug, url.PathEscape(version))
 	req, err := s.client.NewRequest("POST", u, params)
 	if err != nil {
 		return nil, nil, err
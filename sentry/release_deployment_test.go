@@ -0,0 +1,172 @@
+package sentry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// handleDeployPages serves two pages of deploys for release 1.0.0 and counts
+// the requests made for each cursor.
+func handleDeployPages(t *testing.T, mux *http.ServeMux, serverURL string) map[string]int {
+	requests := map[string]int{}
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/releases/1.0.0/deploys/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		cursor := r.URL.Query().Get("cursor")
+		requests[cursor]++
+		w.Header().Set("Content-Type", "application/json")
+		u := serverURL + "/api/0/organizations/the-interstellar-jurisdiction/releases/1.0.0/deploys/"
+		switch cursor {
+		case "":
+			w.Header().Set("Link", fmt.Sprintf(`<%s?&cursor=100:1:0>; rel="next"; results="true"; cursor="100:1:0"`, u))
+			fmt.Fprint(w, `[
+				{"id": "3", "environment": "staging"},
+				{"id": "2", "environment": "production"}
+			]`)
+		case "100:1:0":
+			w.Header().Set("Link", fmt.Sprintf(`<%s?&cursor=100:2:0>; rel="next"; results="false"; cursor="100:2:0"`, u))
+			fmt.Fprint(w, `[{"id": "1", "environment": "qa"}]`)
+		default:
+			t.Errorf("unexpected cursor %q", cursor)
+		}
+	})
+	return requests
+}
+
+func TestReleaseDeploymentsService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/releases/1.0.0/deploys/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"cursor": "100:1:0"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[
+			{
+				"id": "1",
+				"name": "Deploy 1",
+				"environment": "production",
+				"url": "https://example.com",
+				"dateStarted": "2020-01-01T00:00:00Z",
+				"dateFinished": "2020-01-01T00:05:00Z"
+			}
+		]`)
+	})
+
+	ctx := context.Background()
+	deploys, _, err := client.ReleaseDeployments.List(ctx, "the-interstellar-jurisdiction", "1.0.0", &ListCursorParams{Cursor: "100:1:0"})
+	assert.NoError(t, err)
+
+	expected := []*ReleaseDeployment{
+		{
+			ID:           "1",
+			Name:         String("Deploy 1"),
+			Environment:  "production",
+			URL:          String("https://example.com"),
+			DateStarted:  Time(mustParseTime("2020-01-01T00:00:00Z")),
+			DateFinished: Time(mustParseTime("2020-01-01T00:05:00Z")),
+		},
+	}
+	assert.Equal(t, expected, deploys)
+}
+
+func TestReleaseDeploymentsService_ListAll(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	handleDeployPages(t, mux, serverURL)
+
+	ctx := context.Background()
+	deploys, _, err := client.ReleaseDeployments.ListAll(ctx, "the-interstellar-jurisdiction", "1.0.0")
+	assert.NoError(t, err)
+	assert.Len(t, deploys, 3)
+}
+
+func TestReleaseDeploymentsService_Find_stopsEarly(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	requests := handleDeployPages(t, mux, serverURL)
+
+	ctx := context.Background()
+	deploy, _, err := client.ReleaseDeployments.Find(ctx, "the-interstellar-jurisdiction", "1.0.0", &FindReleaseDeploymentParams{
+		Environment: String("production"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &ReleaseDeployment{ID: "2", Environment: "production"}, deploy)
+	assert.Equal(t, map[string]int{"": 1}, requests)
+}
+
+func TestReleaseDeploymentsService_Find_nextPage(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	requests := handleDeployPages(t, mux, serverURL)
+
+	ctx := context.Background()
+	deploy, _, err := client.ReleaseDeployments.Find(ctx, "the-interstellar-jurisdiction", "1.0.0", &FindReleaseDeploymentParams{
+		ID:          String("1"),
+		Environment: String("qa"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &ReleaseDeployment{ID: "1", Environment: "qa"}, deploy)
+	assert.Equal(t, map[string]int{"": 1, "100:1:0": 1}, requests)
+}
+
+func TestReleaseDeploymentsService_Get_notFound(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	handleDeployPages(t, mux, serverURL)
+
+	ctx := context.Background()
+	deploy, resp, err := client.ReleaseDeployments.Get(ctx, "the-interstellar-jurisdiction", "1.0.0", "4")
+	assert.NoError(t, err)
+	assert.Nil(t, deploy)
+	assert.NotNil(t, resp)
+}
+
+func TestReleaseDeploymentsService_Get_escapesVersion(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/releases/my-app@1.0.0+build/1/deploys/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assert.Equal(t, "/api/0/organizations/the-interstellar-jurisdiction/releases/my-app@1.0.0+build%2F1/deploys/", r.URL.EscapedPath())
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"id": "1", "environment": "production"}]`)
+	})
+
+	ctx := context.Background()
+	deploy, _, err := client.ReleaseDeployments.Get(ctx, "the-interstellar-jurisdiction", "my-app@1.0.0+build/1", "1")
+	assert.NoError(t, err)
+	assert.Equal(t, &ReleaseDeployment{ID: "1", Environment: "production"}, deploy)
+}
+
+func TestReleaseDeploymentsService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/releases/my-app@1.0.0+build/1/deploys/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assert.Equal(t, "/api/0/organizations/the-interstellar-jurisdiction/releases/my-app@1.0.0+build%2F1/deploys/", r.URL.EscapedPath())
+		assertPostJSON(t, map[string]interface{}{
+			"id":          "",
+			"environment": "production",
+			"projects":    []interface{}{"pump-station"},
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id": "1", "environment": "production"}`)
+	})
+
+	ctx := context.Background()
+	deploy, _, err := client.ReleaseDeployments.Create(ctx, "the-interstellar-jurisdiction", "my-app@1.0.0+build/1", &ReleaseDeployment{
+		Environment: "production",
+		Projects:    []string{"pump-station"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &ReleaseDeployment{ID: "1", Environment: "production"}, deploy)
+}
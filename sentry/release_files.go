@@ -0,0 +1,131 @@
+package sentry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"sort"
+	"time"
+)
+
+// ReleaseFile represents a file, such as a source map, uploaded to a release.
+// https://github.com/getsentry/sentry/blob/24.10.0/src/sentry/api/serializers/models/release_file.py
+type ReleaseFile struct {
+	ID          *string           `json:"id,omitempty"`
+	Name        *string           `json:"name,omitempty"`
+	Dist        *string           `json:"dist,omitempty"`
+	Headers     map[string]string `json:"headers,omitempty"`
+	Size        *int              `json:"size,omitempty"`
+	SHA1        *string           `json:"sha1,omitempty"`
+	DateCreated *time.Time        `json:"dateCreated,omitempty"`
+}
+
+// ReleaseFilesService provides methods for accessing Sentry release file API endpoints.
+// https://docs.sentry.io/api/releases/
+type ReleaseFilesService service
+
+// List the files of an organization release.
+// https://docs.sentry.io/api/releases/list-an-organizations-release-files/
+func (s *ReleaseFilesService) List(ctx context.Context, organizationSlug string, version string, params *ListCursorParams) ([]*ReleaseFile, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/releases/%v/files/", organizationSlug, url.PathEscape(version))
+	return s.list(ctx, u, params)
+}
+
+// ListByProject lists the files of a project release.
+// https://docs.sentry.io/api/releases/list-a-projects-release-files/
+func (s *ReleaseFilesService) ListByProject(ctx context.Context, organizationSlug string, projectSlug string, version string, params *ListCursorParams) ([]*ReleaseFile, *Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/releases/%v/files/", organizationSlug, projectSlug, url.PathEscape(version))
+	return s.list(ctx, u, params)
+}
+
+func (s *ReleaseFilesService) list(ctx context.Context, u string, params *ListCursorParams) ([]*ReleaseFile, *Response, error) {
+	u, err := addQuery(u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	files := []*ReleaseFile{}
+	resp, err := s.client.Do(ctx, req, &files)
+	if err != nil {
+		return nil, resp, err
+	}
+	return files, resp, nil
+}
+
+// UploadReleaseFileParams are the parameters for ReleaseFilesService.Upload.
+type UploadReleaseFileParams struct {
+	// Name is the full URL or path of the file, e.g. "~/static/app.js.map".
+	Name    string
+	Dist    *string
+	Headers map[string]string
+	Content io.Reader
+}
+
+// Upload a file to an organization release.
+// Artifact bundles uploaded with ChunkUploadsService.UploadArtifactBundle
+// are preferred for large numbers of files.
+// https://docs.sentry.io/api/releases/upload-a-new-organization-release-file/
+func (s *ReleaseFilesService) Upload(ctx context.Context, organizationSlug string, version string, params *UploadReleaseFileParams) (*ReleaseFile, *Response, error) {
+	if params == nil || params.Content == nil {
+		return nil, nil, errors.New("sentry: release file content is required")
+	}
+
+	form := &MultipartForm{}
+	form.AddField("name", params.Name)
+	if params.Dist != nil {
+		form.AddField("dist", *params.Dist)
+	}
+	keys := make([]string, 0, len(params.Headers))
+	for k := range params.Headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		form.AddField("header", fmt.Sprintf("%s:%s", k, params.Headers[k]))
+	}
+	form.AddFile("file", path.Base(params.Name), params.Content)
+
+	u := fmt.Sprintf("0/organizations/%v/releases/%v/files/", organizationSlug, url.PathEscape(version))
+	req, err := s.client.NewRequest("POST", u, form)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	file := new(ReleaseFile)
+	resp, err := s.client.Do(ctx, req, file)
+	if err != nil {
+		return nil, resp, err
+	}
+	return file, resp, nil
+}
+
+// Delete a file of an organization release.
+// https://docs.sentry.io/api/releases/delete-an-organization-releases-file/
+func (s *ReleaseFilesService) Delete(ctx context.Context, organizationSlug string, version string, fileID string) (*Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/releases/%v/files/%v/", organizationSlug, url.PathEscape(version), fileID)
+	return s.delete(ctx, u)
+}
+
+// DeleteByProject deletes a file of a project release.
+// https://docs.sentry.io/api/releases/delete-a-project-releases-file/
+func (s *ReleaseFilesService) DeleteByProject(ctx context.Context, organizationSlug string, projectSlug string, version string, fileID string) (*Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/releases/%v/files/%v/", organizationSlug, projectSlug, url.PathEscape(version), fileID)
+	return s.delete(ctx, u)
+}
+
+func (s *ReleaseFilesService) delete(ctx context.Context, u string) (*Response, error) {
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,147 @@
+package sentry
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReleaseFilesService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/releases/my-app@1.0.0/files/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[
+			{
+				"id": "1",
+				"name": "~/static/app.js.map",
+				"dist": null,
+				"headers": {"Content-Type": "application/json"},
+				"size": 1024,
+				"sha1": "2fb9f4a5a8a0b9e4e5a4b0f6d5c6a6e1f1f2d3e4",
+				"dateCreated": "2018-11-06T21:20:19.150Z"
+			}
+		]`)
+	})
+
+	ctx := context.Background()
+	files, _, err := client.ReleaseFiles.List(ctx, "the-interstellar-jurisdiction", "my-app@1.0.0", nil)
+	assert.NoError(t, err)
+
+	expected := []*ReleaseFile{
+		{
+			ID:          String("1"),
+			Name:        String("~/static/app.js.map"),
+			Headers:     map[string]string{"Content-Type": "application/json"},
+			Size:        Int(1024),
+			SHA1:        String("2fb9f4a5a8a0b9e4e5a4b0f6d5c6a6e1f1f2d3e4"),
+			DateCreated: Time(mustParseTime("2018-11-06T21:20:19.150Z")),
+		},
+	}
+	assert.Equal(t, expected, files)
+}
+
+func TestReleaseFilesService_ListByProject(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/releases/1.0.0/files/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"cursor": "100:1:0"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"id": "1"}]`)
+	})
+
+	ctx := context.Background()
+	files, _, err := client.ReleaseFiles.ListByProject(ctx, "the-interstellar-jurisdiction", "pump-station", "1.0.0", &ListCursorParams{Cursor: "100:1:0"})
+	assert.NoError(t, err)
+	assert.Equal(t, []*ReleaseFile{{ID: String("1")}}, files)
+}
+
+func TestReleaseFilesService_Upload(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/releases/1.0.0/files/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assert.NoError(t, r.ParseMultipartForm(1<<20))
+		assert.Equal(t, []string{"~/static/app.js"}, r.MultipartForm.Value["name"])
+		assert.Equal(t, []string{"web"}, r.MultipartForm.Value["dist"])
+		assert.Equal(t, []string{"Content-Type:text/javascript", "X-SourceMap:app.js.map"}, r.MultipartForm.Value["header"])
+
+		f, header, err := r.FormFile("file")
+		if assert.NoError(t, err) {
+			defer f.Close()
+			assert.Equal(t, "app.js", header.Filename)
+			content, _ := io.ReadAll(f)
+			assert.Equal(t, "console.log(1)", string(content))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": "1", "name": "~/static/app.js", "dist": "web", "size": 14}`)
+	})
+
+	ctx := context.Background()
+	file, _, err := client.ReleaseFiles.Upload(ctx, "the-interstellar-jurisdiction", "1.0.0", &UploadReleaseFileParams{
+		Name: "~/static/app.js",
+		Dist: String("web"),
+		Headers: map[string]string{
+			"X-SourceMap":  "app.js.map",
+			"Content-Type": "text/javascript",
+		},
+		Content: strings.NewReader("console.log(1)"),
+	})
+	assert.NoError(t, err)
+
+	expected := &ReleaseFile{
+		ID:   String("1"),
+		Name: String("~/static/app.js"),
+		Dist: String("web"),
+		Size: Int(14),
+	}
+	assert.Equal(t, expected, file)
+}
+
+func TestReleaseFilesService_Upload_noContent(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/releases/1.0.0/files/", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected request")
+	})
+
+	ctx := context.Background()
+	for _, params := range []*UploadReleaseFileParams{nil, {Name: "~/static/app.js"}} {
+		_, _, err := client.ReleaseFiles.Upload(ctx, "the-interstellar-jurisdiction", "1.0.0", params)
+		assert.EqualError(t, err, "sentry: release file content is required")
+	}
+}
+
+func TestReleaseFilesService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/releases/1.0.0/files/1/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/releases/1.0.0/files/2/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.ReleaseFiles.Delete(ctx, "the-interstellar-jurisdiction", "1.0.0", "1")
+	assert.NoError(t, err)
+
+	_, err = client.ReleaseFiles.DeleteByProject(ctx, "the-interstellar-jurisdiction", "pump-station", "1.0.0", "2")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,349 @@
+package sentry
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// Release represents a Sentry release.
+// https://github.com/getsentry/sentry/blob/24.10.0/src/sentry/api/serializers/models/release.py
+type Release struct {
+	ID           *int                   `json:"id,omitempty"`
+	Version      *string                `json:"version,omitempty"`
+	ShortVersion *string                `json:"shortVersion,omitempty"`
+	Status       *string                `json:"status,omitempty"`
+	Ref          *string                `json:"ref,omitempty"`
+	URL          *string                `json:"url,omitempty"`
+	Data         map[string]interface{} `json:"data,omitempty"`
+	DateCreated  *time.Time             `json:"dateCreated,omitempty"`
+	DateReleased *time.Time             `json:"dateReleased,omitempty"`
+	DateStarted  *time.Time             `json:"dateStarted,omitempty"`
+	FirstEvent   *time.Time             `json:"firstEvent,omitempty"`
+	LastEvent    *time.Time             `json:"lastEvent,omitempty"`
+	NewGroups    *int                   `json:"newGroups,omitempty"`
+	CommitCount  *int                   `json:"commitCount,omitempty"`
+	LastCommit   *ReleaseCommit         `json:"lastCommit,omitempty"`
+	DeployCount  *int                   `json:"deployCount,omitempty"`
+	LastDeploy   *ReleaseDeployment     `json:"lastDeploy,omitempty"`
+	Authors      []*ReleaseCommitAuthor `json:"authors,omitempty"`
+	Projects     []*ReleaseProject      `json:"projects,omitempty"`
+}
+
+// ReleaseProject represents a project associated with a release.
+type ReleaseProject struct {
+	ID        *int     `json:"id,omitempty"`
+	Slug      *string  `json:"slug,omitempty"`
+	Name      *string  `json:"name,omitempty"`
+	NewGroups *int     `json:"newGroups,omitempty"`
+	Platform  *string  `json:"platform,omitempty"`
+	Platforms []string `json:"platforms,omitempty"`
+}
+
+// ReleaseCommit represents a commit associated with a release.
+// https://github.com/getsentry/sentry/blob/24.10.0/src/sentry/api/serializers/models/commit.py
+type ReleaseCommit struct {
+	ID          *string                 `json:"id,omitempty"`
+	Message     *string                 `json:"message,omitempty"`
+	DateCreated *time.Time              `json:"dateCreated,omitempty"`
+	Author      *ReleaseCommitAuthor    `json:"author,omitempty"`
+	Repository  *OrganizationRepository `json:"repository,omitempty"`
+}
+
+// ReleaseCommitAuthor represents the author of a commit. Authors matched to
+// a Sentry user also carry the user's ID and username.
+type ReleaseCommitAuthor struct {
+	ID       *string `json:"id,omitempty"`
+	Name     *string `json:"name,omitempty"`
+	Username *string `json:"username,omitempty"`
+	Email    *string `json:"email,omitempty"`
+}
+
+// ReleaseCommitFile represents a file changed by a commit of a release.
+// https://github.com/getsentry/sentry/blob/24.10.0/src/sentry/api/serializers/models/commit_file_change.py
+type ReleaseCommitFile struct {
+	ID            *string              `json:"id,omitempty"`
+	OrgID         *string              `json:"orgId,omitempty"`
+	Author        *ReleaseCommitAuthor `json:"author,omitempty"`
+	CommitMessage *string              `json:"commitMessage,omitempty"`
+	Filename      *string              `json:"filename,omitempty"`
+	// Type is the kind of change: "A" (added), "M" (modified) or "D" (deleted).
+	Type     *string `json:"type,omitempty"`
+	RepoName *string `json:"repoName,omitempty"`
+}
+
+// ReleaseRef points a release at a commit of a repository. Sentry computes
+// the commits of the release from the commit range between PreviousCommit
+// (or the previous release) and Commit.
+type ReleaseRef struct {
+	// Repository is the name of an OrganizationRepository.
+	Repository     string  `json:"repository"`
+	Commit         string  `json:"commit"`
+	PreviousCommit *string `json:"previousCommit,omitempty"`
+}
+
+// NewReleaseRef returns a ReleaseRef for a commit of the given repository.
+func NewReleaseRef(repo *OrganizationRepository, commit string) *ReleaseRef {
+	return &ReleaseRef{
+		Repository: repo.Name,
+		Commit:     commit,
+	}
+}
+
+// ReleaseCommitParams represents a commit explicitly associated with a release.
+type ReleaseCommitParams struct {
+	ID          string                    `json:"id"`
+	Repository  *string                   `json:"repository,omitempty"`
+	Message     *string                   `json:"message,omitempty"`
+	AuthorName  *string                   `json:"author_name,omitempty"`
+	AuthorEmail *string                   `json:"author_email,omitempty"`
+	Timestamp   *time.Time                `json:"timestamp,omitempty"`
+	PatchSet    []*ReleaseCommitPatchFile `json:"patch_set,omitempty"`
+}
+
+// ReleaseCommitPatchFile represents a file changed by a commit.
+type ReleaseCommitPatchFile struct {
+	Path string `json:"path"`
+	// Type is the kind of change: "A" (added), "M" (modified) or "D" (deleted).
+	Type string `json:"type"`
+}
+
+// ReleasesService provides methods for accessing Sentry release API endpoints.
+// https://docs.sentry.io/api/releases/
+type ReleasesService service
+
+// ListReleasesParams are the parameters for ReleasesService.List.
+type ListReleasesParams struct {
+	ListCursorParams
+
+	// Query filters releases by version prefix.
+	Query       *string  `url:"query,omitempty"`
+	Project     []int    `url:"project,omitempty"`
+	Environment []string `url:"environment,omitempty"`
+	Sort        *string  `url:"sort,omitempty"`
+	Status      *string  `url:"status,omitempty"`
+}
+
+// List organization releases.
+// https://docs.sentry.io/api/releases/list-an-organizations-releases/
+func (s *ReleasesService) List(ctx context.Context, organizationSlug string, params *ListReleasesParams) ([]*Release, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/releases/", organizationSlug)
+	return s.list(ctx, u, params)
+}
+
+// ListAll returns all organization releases, following pagination.
+func (s *ReleasesService) ListAll(ctx context.Context, organizationSlug string, params *ListReleasesParams) ([]*Release, *Response, error) {
+	p := ListReleasesParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewPager(func(ctx context.Context, cursor string) ([]*Release, *Response, error) {
+		p.Cursor = cursor
+		return s.List(ctx, organizationSlug, &p)
+	}).All(ctx)
+}
+
+// ListProjectReleasesParams are the parameters for ReleasesService.ListByProject.
+type ListProjectReleasesParams struct {
+	ListCursorParams
+
+	// Query filters releases by version prefix.
+	Query *string `url:"query,omitempty"`
+}
+
+// ListByProject lists the releases of a project.
+// https://docs.sentry.io/api/releases/list-a-projects-releases/
+func (s *ReleasesService) ListByProject(ctx context.Context, organizationSlug string, projectSlug string, params *ListProjectReleasesParams) ([]*Release, *Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/releases/", organizationSlug, projectSlug)
+	return s.list(ctx, u, params)
+}
+
+func (s *ReleasesService) list(ctx context.Context, u string, params interface{}) ([]*Release, *Response, error) {
+	u, err := addQuery(u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	releases := []*Release{}
+	resp, err := s.client.Do(ctx, req, &releases)
+	if err != nil {
+		return nil, resp, err
+	}
+	return releases, resp, nil
+}
+
+// Get an organization release.
+// https://docs.sentry.io/api/releases/retrieve-an-organizations-release/
+func (s *ReleasesService) Get(ctx context.Context, organizationSlug string, version string) (*Release, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/releases/%v/", organizationSlug, url.PathEscape(version))
+	return s.do(ctx, "GET", u, nil)
+}
+
+// GetByProject returns a release of a project.
+func (s *ReleasesService) GetByProject(ctx context.Context, organizationSlug string, projectSlug string, version string) (*Release, *Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/releases/%v/", organizationSlug, projectSlug, url.PathEscape(version))
+	return s.do(ctx, "GET", u, nil)
+}
+
+// CreateReleaseParams are the parameters for ReleasesService.Create and
+// ReleasesService.CreateByProject.
+type CreateReleaseParams struct {
+	Version string `json:"version"`
+	// Projects is required when creating an organization release, and
+	// ignored when creating a project release.
+	Projects     []string               `json:"projects,omitempty"`
+	Ref          *string                `json:"ref,omitempty"`
+	URL          *string                `json:"url,omitempty"`
+	DateReleased *time.Time             `json:"dateReleased,omitempty"`
+	Commits      []*ReleaseCommitParams `json:"commits,omitempty"`
+	Refs         []*ReleaseRef          `json:"refs,omitempty"`
+}
+
+// Create a new organization release.
+// https://docs.sentry.io/api/releases/create-a-new-release-for-an-organization/
+func (s *ReleasesService) Create(ctx context.Context, organizationSlug string, params *CreateReleaseParams) (*Release, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/releases/", organizationSlug)
+	return s.do(ctx, "POST", u, params)
+}
+
+// CreateByProject creates a new release of a project.
+func (s *ReleasesService) CreateByProject(ctx context.Context, organizationSlug string, projectSlug string, params *CreateReleaseParams) (*Release, *Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/releases/", organizationSlug, projectSlug)
+	return s.do(ctx, "POST", u, params)
+}
+
+// UpdateReleaseParams are the parameters for ReleasesService.Update and
+// ReleasesService.UpdateByProject.
+type UpdateReleaseParams struct {
+	Ref          *string                `json:"ref,omitempty"`
+	URL          *string                `json:"url,omitempty"`
+	DateReleased *time.Time             `json:"dateReleased,omitempty"`
+	Commits      []*ReleaseCommitParams `json:"commits,omitempty"`
+	Refs         []*ReleaseRef          `json:"refs,omitempty"`
+}
+
+// Update an organization release.
+// https://docs.sentry.io/api/releases/update-an-organizations-release/
+func (s *ReleasesService) Update(ctx context.Context, organizationSlug string, version string, params *UpdateReleaseParams) (*Release, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/releases/%v/", organizationSlug, url.PathEscape(version))
+	return s.do(ctx, "PUT", u, params)
+}
+
+// UpdateByProject updates a release of a project.
+func (s *ReleasesService) UpdateByProject(ctx context.Context, organizationSlug string, projectSlug string, version string, params *UpdateReleaseParams) (*Release, *Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/releases/%v/", organizationSlug, projectSlug, url.PathEscape(version))
+	return s.do(ctx, "PUT", u, params)
+}
+
+// Finalize marks an organization release as released now.
+func (s *ReleasesService) Finalize(ctx context.Context, organizationSlug string, version string) (*Release, *Response, error) {
+	return s.Update(ctx, organizationSlug, version, &UpdateReleaseParams{
+		DateReleased: Time(time.Now().UTC()),
+	})
+}
+
+// SetCommits associates commits with an organization release. Each ref
+// names a repository of the organization and the commit the release was
+// built from.
+func (s *ReleasesService) SetCommits(ctx context.Context, organizationSlug string, version string, refs []*ReleaseRef) (*Release, *Response, error) {
+	return s.Update(ctx, organizationSlug, version, &UpdateReleaseParams{
+		Refs: refs,
+	})
+}
+
+func (s *ReleasesService) do(ctx context.Context, method string, u string, params interface{}) (*Release, *Response, error) {
+	req, err := s.client.NewRequest(method, u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	release := new(Release)
+	resp, err := s.client.Do(ctx, req, release)
+	if err != nil {
+		return nil, resp, err
+	}
+	return release, resp, nil
+}
+
+// Delete an organization release.
+// https://docs.sentry.io/api/releases/delete-an-organizations-release/
+func (s *ReleasesService) Delete(ctx context.Context, organizationSlug string, version string) (*Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/releases/%v/", organizationSlug, url.PathEscape(version))
+	return s.delete(ctx, u)
+}
+
+// DeleteByProject deletes a release of a project.
+func (s *ReleasesService) DeleteByProject(ctx context.Context, organizationSlug string, projectSlug string, version string) (*Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/releases/%v/", organizationSlug, projectSlug, url.PathEscape(version))
+	return s.delete(ctx, u)
+}
+
+func (s *ReleasesService) delete(ctx context.Context, u string) (*Response, error) {
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListCommits lists the commits of an organization release.
+// https://docs.sentry.io/api/releases/list-an-organization-releases-commits/
+func (s *ReleasesService) ListCommits(ctx context.Context, organizationSlug string, version string, params *ListCursorParams) ([]*ReleaseCommit, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/releases/%v/commits/", organizationSlug, url.PathEscape(version))
+	return s.listCommits(ctx, u, params)
+}
+
+// ListCommitsByProject lists the commits of a project release.
+// https://docs.sentry.io/api/releases/list-a-project-releases-commits/
+func (s *ReleasesService) ListCommitsByProject(ctx context.Context, organizationSlug string, projectSlug string, version string, params *ListCursorParams) ([]*ReleaseCommit, *Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/releases/%v/commits/", organizationSlug, projectSlug, url.PathEscape(version))
+	return s.listCommits(ctx, u, params)
+}
+
+func (s *ReleasesService) listCommits(ctx context.Context, u string, params *ListCursorParams) ([]*ReleaseCommit, *Response, error) {
+	u, err := addQuery(u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	commits := []*ReleaseCommit{}
+	resp, err := s.client.Do(ctx, req, &commits)
+	if err != nil {
+		return nil, resp, err
+	}
+	return commits, resp, nil
+}
+
+// ListCommitFiles lists the files changed by the commits of an organization release.
+// https://docs.sentry.io/api/releases/retrieve-files-changed-in-a-releases-commits/
+func (s *ReleasesService) ListCommitFiles(ctx context.Context, organizationSlug string, version string, params *ListCursorParams) ([]*ReleaseCommitFile, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/releases/%v/commitfiles/", organizationSlug, url.PathEscape(version))
+	u, err := addQuery(u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	files := []*ReleaseCommitFile{}
+	resp, err := s.client.Do(ctx, req, &files)
+	if err != nil {
+		return nil, resp, err
+	}
+	return files, resp, nil
+}
@@ -0,0 +1,442 @@
+package sentry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReleasesService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/releases/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"query": "2.0", "project": "2"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[
+			{
+				"id": 2,
+				"version": "2.0rc2",
+				"shortVersion": "2.0rc2",
+				"status": "open",
+				"ref": "6ba09a7c53235ee8a8fa5ee4c1ca8ca886e7fdbb",
+				"url": null,
+				"dateCreated": "2018-11-06T21:20:08.033Z",
+				"dateReleased": null,
+				"newGroups": 0,
+				"commitCount": 0,
+				"lastCommit": null,
+				"deployCount": 0,
+				"lastDeploy": null,
+				"authors": [],
+				"projects": [
+					{
+						"id": 2,
+						"slug": "pump-station",
+						"name": "Pump Station",
+						"newGroups": 0,
+						"platform": "python",
+						"platforms": ["python"]
+					}
+				],
+				"data": {}
+			}
+		]`)
+	})
+
+	ctx := context.Background()
+	releases, _, err := client.Releases.List(ctx, "the-interstellar-jurisdiction", &ListReleasesParams{
+		Query:   String("2.0"),
+		Project: []int{2},
+	})
+	assert.NoError(t, err)
+
+	expected := []*Release{
+		{
+			ID:           Int(2),
+			Version:      String("2.0rc2"),
+			ShortVersion: String("2.0rc2"),
+			Status:       String("open"),
+			Ref:          String("6ba09a7c53235ee8a8fa5ee4c1ca8ca886e7fdbb"),
+			DateCreated:  Time(mustParseTime("2018-11-06T21:20:08.033Z")),
+			NewGroups:    Int(0),
+			CommitCount:  Int(0),
+			DeployCount:  Int(0),
+			Authors:      []*ReleaseCommitAuthor{},
+			Projects: []*ReleaseProject{
+				{
+					ID:        Int(2),
+					Slug:      String("pump-station"),
+					Name:      String("Pump Station"),
+					NewGroups: Int(0),
+					Platform:  String("python"),
+					Platforms: []string{"python"},
+				},
+			},
+			Data: map[string]interface{}{},
+		},
+	}
+	assert.Equal(t, expected, releases)
+}
+
+func TestReleasesService_ListByProject(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/releases/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"cursor": "100:1:0"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"version": "2.0rc2"}]`)
+	})
+
+	ctx := context.Background()
+	releases, _, err := client.Releases.ListByProject(ctx, "the-interstellar-jurisdiction", "pump-station", &ListProjectReleasesParams{
+		ListCursorParams: ListCursorParams{Cursor: "100:1:0"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []*Release{{Version: String("2.0rc2")}}, releases)
+}
+
+func TestReleasesService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/releases/my-app@1.0.0+build/1/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assert.Equal(t, "/api/0/organizations/the-interstellar-jurisdiction/releases/my-app@1.0.0+build%2F1/", r.URL.EscapedPath())
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"version": "my-app@1.0.0+build/1",
+			"lastCommit": {
+				"id": "a24c8ab4e3e4a8a1b8a0c5ad0a3b4c0f1d2e3f40",
+				"message": "Fix the thing",
+				"dateCreated": "2018-11-06T21:19:58Z",
+				"author": {"name": "Jane", "email": "jane@example.com"},
+				"repository": {
+					"id": "1",
+					"name": "getsentry/pump-station",
+					"url": "https://github.com/getsentry/pump-station",
+					"provider": {"id": "integrations:github", "name": "GitHub"},
+					"status": "active"
+				}
+			},
+			"lastDeploy": {
+				"id": "1",
+				"environment": "production"
+			}
+		}`)
+	})
+
+	ctx := context.Background()
+	release, _, err := client.Releases.Get(ctx, "the-interstellar-jurisdiction", "my-app@1.0.0+build/1")
+	assert.NoError(t, err)
+
+	expected := &Release{
+		Version: String("my-app@1.0.0+build/1"),
+		LastCommit: &ReleaseCommit{
+			ID:          String("a24c8ab4e3e4a8a1b8a0c5ad0a3b4c0f1d2e3f40"),
+			Message:     String("Fix the thing"),
+			DateCreated: Time(mustParseTime("2018-11-06T21:19:58Z")),
+			Author: &ReleaseCommitAuthor{
+				Name:  String("Jane"),
+				Email: String("jane@example.com"),
+			},
+			Repository: &OrganizationRepository{
+				ID:   "1",
+				Name: "getsentry/pump-station",
+				Url:  "https://github.com/getsentry/pump-station",
+				Provider: OrganizationRepositoryProvider{
+					ID:   "integrations:github",
+					Name: "GitHub",
+				},
+				Status: "active",
+			},
+		},
+		LastDeploy: &ReleaseDeployment{
+			ID:          "1",
+			Environment: "production",
+		},
+	}
+	assert.Equal(t, expected, release)
+}
+
+func TestReleasesService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/releases/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assertPostJSON(t, map[string]interface{}{
+			"version":  "2.0rc2",
+			"projects": []interface{}{"pump-station"},
+			"ref":      "6ba09a7c53235ee8a8fa5ee4c1ca8ca886e7fdbb",
+			"refs": []interface{}{
+				map[string]interface{}{
+					"repository":     "getsentry/pump-station",
+					"commit":         "6ba09a7c53235ee8a8fa5ee4c1ca8ca886e7fdbb",
+					"previousCommit": "a24c8ab4e3e4a8a1b8a0c5ad0a3b4c0f1d2e3f40",
+				},
+			},
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"version": "2.0rc2", "status": "open"}`)
+	})
+
+	ctx := context.Background()
+	release, _, err := client.Releases.Create(ctx, "the-interstellar-jurisdiction", &CreateReleaseParams{
+		Version:  "2.0rc2",
+		Projects: []string{"pump-station"},
+		Ref:      String("6ba09a7c53235ee8a8fa5ee4c1ca8ca886e7fdbb"),
+		Refs: []*ReleaseRef{
+			{
+				Repository:     "getsentry/pump-station",
+				Commit:         "6ba09a7c53235ee8a8fa5ee4c1ca8ca886e7fdbb",
+				PreviousCommit: String("a24c8ab4e3e4a8a1b8a0c5ad0a3b4c0f1d2e3f40"),
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &Release{Version: String("2.0rc2"), Status: String("open")}, release)
+}
+
+func TestReleasesService_CreateByProject(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/releases/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assertPostJSON(t, map[string]interface{}{
+			"version": "2.0rc2",
+			"commits": []interface{}{
+				map[string]interface{}{
+					"id":         "6ba09a7c53235ee8a8fa5ee4c1ca8ca886e7fdbb",
+					"repository": "getsentry/pump-station",
+					"patch_set": []interface{}{
+						map[string]interface{}{"path": "src/app.py", "type": "M"},
+					},
+				},
+			},
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"version": "2.0rc2"}`)
+	})
+
+	ctx := context.Background()
+	release, _, err := client.Releases.CreateByProject(ctx, "the-interstellar-jurisdiction", "pump-station", &CreateReleaseParams{
+		Version: "2.0rc2",
+		Commits: []*ReleaseCommitParams{
+			{
+				ID:         "6ba09a7c53235ee8a8fa5ee4c1ca8ca886e7fdbb",
+				Repository: String("getsentry/pump-station"),
+				PatchSet: []*ReleaseCommitPatchFile{
+					{Path: "src/app.py", Type: "M"},
+				},
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &Release{Version: String("2.0rc2")}, release)
+}
+
+func TestReleasesService_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/releases/2.0rc2/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		assertPostJSON(t, map[string]interface{}{
+			"url":          "https://example.com/releases/2.0rc2",
+			"dateReleased": "2018-11-06T21:20:08Z",
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"version": "2.0rc2", "dateReleased": "2018-11-06T21:20:08Z"}`)
+	})
+
+	ctx := context.Background()
+	release, _, err := client.Releases.Update(ctx, "the-interstellar-jurisdiction", "2.0rc2", &UpdateReleaseParams{
+		URL:          String("https://example.com/releases/2.0rc2"),
+		DateReleased: Time(mustParseTime("2018-11-06T21:20:08Z")),
+	})
+	assert.NoError(t, err)
+
+	expected := &Release{
+		Version:      String("2.0rc2"),
+		DateReleased: Time(mustParseTime("2018-11-06T21:20:08Z")),
+	}
+	assert.Equal(t, expected, release)
+}
+
+func TestReleasesService_Finalize(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	before := time.Now().UTC().Truncate(time.Second)
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/releases/2.0rc2/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		var body UpdateReleaseParams
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		if assert.NotNil(t, body.DateReleased) {
+			assert.False(t, body.DateReleased.Before(before))
+		}
+		assert.Nil(t, body.Refs)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"version": "2.0rc2"}`)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.Releases.Finalize(ctx, "the-interstellar-jurisdiction", "2.0rc2")
+	assert.NoError(t, err)
+}
+
+func TestReleasesService_SetCommits(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/releases/2.0rc2/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		assertPostJSON(t, map[string]interface{}{
+			"refs": []interface{}{
+				map[string]interface{}{
+					"repository": "getsentry/pump-station",
+					"commit":     "6ba09a7c53235ee8a8fa5ee4c1ca8ca886e7fdbb",
+				},
+			},
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"version": "2.0rc2", "commitCount": 3}`)
+	})
+
+	repo := &OrganizationRepository{ID: "1", Name: "getsentry/pump-station"}
+
+	ctx := context.Background()
+	release, _, err := client.Releases.SetCommits(ctx, "the-interstellar-jurisdiction", "2.0rc2", []*ReleaseRef{
+		NewReleaseRef(repo, "6ba09a7c53235ee8a8fa5ee4c1ca8ca886e7fdbb"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &Release{Version: String("2.0rc2"), CommitCount: Int(3)}, release)
+}
+
+func TestReleasesService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/releases/2.0rc2/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/releases/2.0rc2/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Releases.Delete(ctx, "the-interstellar-jurisdiction", "2.0rc2")
+	assert.NoError(t, err)
+
+	_, err = client.Releases.DeleteByProject(ctx, "the-interstellar-jurisdiction", "pump-station", "2.0rc2")
+	assert.NoError(t, err)
+}
+
+func TestReleasesService_ListCommits(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/releases/2.0rc2/commits/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[
+			{
+				"id": "6ba09a7c53235ee8a8fa5ee4c1ca8ca886e7fdbb",
+				"message": "Initial commit",
+				"dateCreated": "2018-11-06T21:19:58Z",
+				"author": {
+					"id": "1",
+					"name": "Jane",
+					"username": "jane",
+					"email": "jane@example.com"
+				}
+			}
+		]`)
+	})
+
+	ctx := context.Background()
+	commits, _, err := client.Releases.ListCommits(ctx, "the-interstellar-jurisdiction", "2.0rc2", nil)
+	assert.NoError(t, err)
+
+	expected := []*ReleaseCommit{
+		{
+			ID:          String("6ba09a7c53235ee8a8fa5ee4c1ca8ca886e7fdbb"),
+			Message:     String("Initial commit"),
+			DateCreated: Time(mustParseTime("2018-11-06T21:19:58Z")),
+			Author: &ReleaseCommitAuthor{
+				ID:       String("1"),
+				Name:     String("Jane"),
+				Username: String("jane"),
+				Email:    String("jane@example.com"),
+			},
+		},
+	}
+	assert.Equal(t, expected, commits)
+}
+
+func TestReleasesService_ListCommitsByProject(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/releases/2.0rc2/commits/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"id": "6ba09a7c53235ee8a8fa5ee4c1ca8ca886e7fdbb"}]`)
+	})
+
+	ctx := context.Background()
+	commits, _, err := client.Releases.ListCommitsByProject(ctx, "the-interstellar-jurisdiction", "pump-station", "2.0rc2", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []*ReleaseCommit{{ID: String("6ba09a7c53235ee8a8fa5ee4c1ca8ca886e7fdbb")}}, commits)
+}
+
+func TestReleasesService_ListCommitFiles(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/releases/2.0rc2/commitfiles/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[
+			{
+				"id": "1",
+				"orgId": "1",
+				"author": {"name": "Jane", "email": "jane@example.com"},
+				"commitMessage": "Initial commit",
+				"filename": "src/app.py",
+				"type": "A",
+				"repoName": "getsentry/pump-station"
+			}
+		]`)
+	})
+
+	ctx := context.Background()
+	files, _, err := client.Releases.ListCommitFiles(ctx, "the-interstellar-jurisdiction", "2.0rc2", nil)
+	assert.NoError(t, err)
+
+	expected := []*ReleaseCommitFile{
+		{
+			ID:            String("1"),
+			OrgID:         String("1"),
+			Author:        &ReleaseCommitAuthor{Name: String("Jane"), Email: String("jane@example.com")},
+			CommitMessage: String("Initial commit"),
+			Filename:      String("src/app.py"),
+			Type:          String("A"),
+			RepoName:      String("getsentry/pump-station"),
+		},
+	}
+	assert.Equal(t, expected, files)
+}
@@ -0,0 +1,179 @@
+package sentry
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultRetryMaxAttempts = 3
+	defaultRetryMinBackoff  = 500 * time.Millisecond
+	defaultRetryMaxBackoff  = 30 * time.Second
+)
+
+// RetryPolicy configures automatic retries of failed requests.
+//
+// Requests are retried when Sentry responds with 429, 502, 503 or 504, or when
+// the request fails with a network error. Only GET, HEAD and OPTIONS requests
+// are retried, unless the request context has been marked with MarkIdempotent.
+type RetryPolicy struct {
+	// The maximum number of attempts, including the first one.
+	// Defaults to 3.
+	MaxAttempts int
+
+	// The initial backoff between attempts when the response does not say
+	// when to retry. It doubles after every attempt. Defaults to 500ms.
+	MinBackoff time.Duration
+
+	// The upper bound of the exponential backoff. Defaults to 30s.
+	MaxBackoff time.Duration
+}
+
+// WithRetryPolicy enables automatic retries using the given policy.
+func WithRetryPolicy(policy *RetryPolicy) ClientOption {
+	return func(c *Client) error {
+		c.RetryPolicy = policy
+		return nil
+	}
+}
+
+type idempotentContextKey struct{}
+
+// MarkIdempotent returns a copy of ctx that marks requests made with it as
+// safe to replay, allowing the retry policy to retry POST, PUT and DELETE
+// requests.
+func MarkIdempotent(ctx context.Context) context.Context {
+	return context.WithValue(ctx, idempotentContextKey{}, true)
+}
+
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	v, _ := req.Context().Value(idempotentContextKey{}).(bool)
+	return v
+}
+
+func (p *RetryPolicy) maxAttempts() int {
+	if p.MaxAttempts <= 0 {
+		return defaultRetryMaxAttempts
+	}
+	return p.MaxAttempts
+}
+
+func (p *RetryPolicy) minBackoff() time.Duration {
+	if p.MinBackoff <= 0 {
+		return defaultRetryMinBackoff
+	}
+	return p.MinBackoff
+}
+
+func (p *RetryPolicy) maxBackoff() time.Duration {
+	if p.MaxBackoff <= 0 {
+		return defaultRetryMaxBackoff
+	}
+	return p.MaxBackoff
+}
+
+// shouldRetry reports whether a request that resulted in resp and err may
+// succeed if it is sent again.
+func shouldRetry(ctx context.Context, resp *Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if resp == nil {
+		return err != nil
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// retryDelay returns how long to wait before the next attempt. Rate limited
+// responses wait until the rate limit window resets, or for the duration given
+// by the Retry-After header. Other failures back off exponentially.
+func (p *RetryPolicy) retryDelay(attempt int, resp *Response, now time.Time) time.Duration {
+	jitter := time.Duration(rand.Int63n(int64(p.minBackoff())))
+
+	if resp != nil {
+		if resp.StatusCode == http.StatusTooManyRequests && resp.Rate.Reset.After(now) {
+			return resp.Rate.Reset.Sub(now) + jitter
+		}
+		if d, ok := parseRetryAfter(resp.Header.Get("Retry-After"), now); ok {
+			return d + jitter
+		}
+	}
+
+	backoff := p.minBackoff()
+	for i := 1; i < attempt && backoff < p.maxBackoff(); i++ {
+		backoff *= 2
+	}
+	if backoff > p.maxBackoff() {
+		backoff = p.maxBackoff()
+	}
+	return backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		if d := t.Sub(now); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// doWithRetry sends the request, retrying it according to the client's retry policy.
+func (c *Client) doWithRetry(ctx context.Context, req *http.Request) (*Response, error) {
+	policy := c.RetryPolicy
+	if policy == nil || !isIdempotent(req) || (req.Body != nil && req.GetBody == nil) {
+		return c.doOnce(ctx, req)
+	}
+
+	for attempt := 1; ; attempt++ {
+		resp, err := c.doOnce(ctx, req)
+		if attempt >= policy.maxAttempts() || !shouldRetry(ctx, resp, err) {
+			return resp, err
+		}
+
+		if resp != nil {
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(policy.retryDelay(attempt, resp, time.Now()))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return resp, ctx.Err()
+		case <-timer.C:
+		}
+
+		// Rewind the request body so it can be sent again.
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return resp, err
+			}
+			req.Body = body
+		}
+	}
+}
@@ -0,0 +1,231 @@
+package sentry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicy_retriesServerErrors(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.RetryPolicy = &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond}
+
+	attempts := 0
+	mux.HandleFunc("/api/0/organizations/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"id": "2"}]`)
+	})
+
+	ctx := context.Background()
+	orgs, _, err := client.Organizations.List(ctx, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, attempts)
+	assert.Equal(t, []*Organization{{ID: String("2")}}, orgs)
+}
+
+func TestRetryPolicy_maxAttempts(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.RetryPolicy = &RetryPolicy{MaxAttempts: 2, MinBackoff: time.Millisecond}
+
+	attempts := 0
+	mux.HandleFunc("/api/0/organizations/", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	ctx := context.Background()
+	_, resp, err := client.Organizations.List(ctx, nil)
+	assert.Error(t, err)
+	assert.Equal(t, 2, attempts)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+}
+
+func TestRetryPolicy_doesNotRetryClientErrors(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.RetryPolicy = &RetryPolicy{MinBackoff: time.Millisecond}
+
+	attempts := 0
+	mux.HandleFunc("/api/0/organizations/", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.Organizations.List(ctx, nil)
+	assert.Error(t, err)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestRetryPolicy_nonIdempotent(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.RetryPolicy = &RetryPolicy{MinBackoff: time.Millisecond}
+
+	attempts := 0
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/teams/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.Teams.Create(ctx, "the-interstellar-jurisdiction", &CreateTeamParams{Name: String("Ancient Gabelers")})
+	assert.Error(t, err)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestRetryPolicy_markIdempotentRewindsBody(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.RetryPolicy = &RetryPolicy{MinBackoff: time.Millisecond}
+
+	attempts := 0
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/teams/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assertPostJSON(t, map[string]interface{}{
+			"name": "Ancient Gabelers",
+		}, r)
+		attempts++
+		if attempts == 1 {
+			w.Header().Set(headerRateRemaining, "0")
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": "3", "name": "Ancient Gabelers"}`)
+	})
+
+	ctx := MarkIdempotent(context.Background())
+	team, _, err := client.Teams.Create(ctx, "the-interstellar-jurisdiction", &CreateTeamParams{Name: String("Ancient Gabelers")})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+	assert.Equal(t, &Team{ID: String("3"), Name: String("Ancient Gabelers")}, team)
+}
+
+func TestRetryPolicy_contextCanceledWhileWaiting(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.RetryPolicy = &RetryPolicy{MinBackoff: time.Millisecond}
+
+	mux.HandleFunc("/api/0/organizations/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, _, err := client.Organizations.List(ctx, nil)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+type flakyTransport struct {
+	failures int
+}
+
+func (t *flakyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.failures > 0 {
+		t.failures--
+		return nil, errors.New("connection reset by peer")
+	}
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestRetryPolicy_retriesNetworkErrors(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.client = &http.Client{Transport: &flakyTransport{failures: 2}}
+	client.RetryPolicy = &RetryPolicy{MinBackoff: time.Millisecond}
+
+	mux.HandleFunc("/api/0/organizations/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[]`)
+	})
+
+	ctx := context.Background()
+	orgs, _, err := client.Organizations.List(ctx, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, orgs)
+}
+
+func TestRetryPolicy_retryDelay(t *testing.T) {
+	now := time.Date(2022, time.June, 7, 1, 49, 0, 0, time.UTC)
+	policy := &RetryPolicy{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	testCases := []struct {
+		description string
+		attempt     int
+		resp        *Response
+		min         time.Duration
+		max         time.Duration
+	}{
+		{
+			description: "rate limit reset",
+			attempt:     1,
+			resp: &Response{
+				Response: &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}},
+				Rate:     Rate{Reset: now.Add(2 * time.Second)},
+			},
+			min: 2 * time.Second,
+			max: 2*time.Second + 100*time.Millisecond,
+		},
+		{
+			description: "retry after seconds",
+			attempt:     1,
+			resp: &Response{
+				Response: &http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{"Retry-After": {"5"}}},
+			},
+			min: 5 * time.Second,
+			max: 5*time.Second + 100*time.Millisecond,
+		},
+		{
+			description: "retry after date",
+			attempt:     1,
+			resp: &Response{
+				Response: &http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{"Retry-After": {now.Add(3 * time.Second).Format(http.TimeFormat)}}},
+			},
+			min: 3 * time.Second,
+			max: 3*time.Second + 100*time.Millisecond,
+		},
+		{
+			description: "first backoff",
+			attempt:     1,
+			min:         50 * time.Millisecond,
+			max:         100 * time.Millisecond,
+		},
+		{
+			description: "exponential backoff",
+			attempt:     3,
+			min:         200 * time.Millisecond,
+			max:         400 * time.Millisecond,
+		},
+		{
+			description: "capped backoff",
+			attempt:     10,
+			min:         500 * time.Millisecond,
+			max:         time.Second,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			d := policy.retryDelay(tc.attempt, tc.resp, now)
+			assert.GreaterOrEqual(t, d, tc.min)
+			assert.LessOrEqual(t, d, tc.max)
+		})
+	}
+}
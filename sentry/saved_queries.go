@@ -0,0 +1,185 @@
+package sentry
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Saved query sort orders.
+const (
+	SavedQuerySortByName           = "name"
+	SavedQuerySortByDateCreated    = "dateCreated"
+	SavedQuerySortByDateUpdated    = "dateUpdated"
+	SavedQuerySortByMostPopular    = "mostPopular"
+	SavedQuerySortByRecentlyViewed = "recentlyViewed"
+	SavedQuerySortByMyQueries      = "myqueries"
+)
+
+// SavedQuery represents a saved Discover query.
+// https://github.com/getsentry/sentry/blob/24.10.0/src/sentry/discover/endpoints/serializers.py
+type SavedQuery struct {
+	ID           *string  `json:"id,omitempty"`
+	Name         *string  `json:"name,omitempty"`
+	Projects     []int    `json:"projects,omitempty"`
+	Version      *int     `json:"version,omitempty"`
+	QueryDataset *string  `json:"queryDataset,omitempty"`
+	Fields       []string `json:"fields,omitempty"`
+	Widths       []string `json:"widths,omitempty"`
+	// OrderBy is the field to sort by, prefixed with "-" for descending order.
+	OrderBy     *string    `json:"orderby,omitempty"`
+	Environment []string   `json:"environment,omitempty"`
+	Query       *string    `json:"query,omitempty"`
+	YAxis       []string   `json:"yAxis,omitempty"`
+	Display     *string    `json:"display,omitempty"`
+	TopEvents   *int       `json:"topEvents,omitempty"`
+	Interval    *string    `json:"interval,omitempty"`
+	Range       *string    `json:"range,omitempty"`
+	Start       *time.Time `json:"start,omitempty"`
+	End         *time.Time `json:"end,omitempty"`
+	Expired     *bool      `json:"expired,omitempty"`
+	CreatedBy   *User      `json:"createdBy,omitempty"`
+	DateCreated *time.Time `json:"dateCreated,omitempty"`
+	DateUpdated *time.Time `json:"dateUpdated,omitempty"`
+	LastVisited *time.Time `json:"lastVisited,omitempty"`
+}
+
+// DiscoverQueryParams returns the Discover query parameters running the saved query.
+func (q *SavedQuery) DiscoverQueryParams() *DiscoverQueryParams {
+	params := &DiscoverQueryParams{
+		Field:       q.Fields,
+		Query:       q.Query,
+		Dataset:     q.QueryDataset,
+		Project:     q.Projects,
+		Environment: q.Environment,
+		StatsPeriod: q.Range,
+		Start:       q.Start,
+		End:         q.End,
+	}
+	if q.OrderBy != nil && *q.OrderBy != "" {
+		params.Sort = []string{*q.OrderBy}
+	}
+	return params
+}
+
+// SavedQueriesService provides methods for accessing Sentry saved Discover query API endpoints.
+// https://docs.sentry.io/api/discover/
+type SavedQueriesService service
+
+// ListSavedQueriesParams are the parameters for SavedQueriesService.List.
+type ListSavedQueriesParams struct {
+	ListCursorParams
+
+	// Query filters saved queries by name.
+	Query   *string `url:"query,omitempty"`
+	SortBy  *string `url:"sortBy,omitempty"`
+	PerPage *int    `url:"per_page,omitempty"`
+}
+
+// List saved queries in an organization.
+// https://docs.sentry.io/api/discover/list-an-organizations-discover-saved-queries/
+func (s *SavedQueriesService) List(ctx context.Context, organizationSlug string, params *ListSavedQueriesParams) ([]*SavedQuery, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/discover/saved/", organizationSlug)
+	u, err := addQuery(u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	queries := []*SavedQuery{}
+	resp, err := s.client.Do(ctx, req, &queries)
+	if err != nil {
+		return nil, resp, err
+	}
+	return queries, resp, nil
+}
+
+// ListAll returns all saved queries in an organization, following pagination.
+func (s *SavedQueriesService) ListAll(ctx context.Context, organizationSlug string, params *ListSavedQueriesParams) ([]*SavedQuery, *Response, error) {
+	p := ListSavedQueriesParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewPager(func(ctx context.Context, cursor string) ([]*SavedQuery, *Response, error) {
+		p.Cursor = cursor
+		return s.List(ctx, organizationSlug, &p)
+	}).All(ctx)
+}
+
+// Get a saved query.
+// https://docs.sentry.io/api/discover/retrieve-an-organizations-discover-saved-query/
+func (s *SavedQueriesService) Get(ctx context.Context, organizationSlug string, id string) (*SavedQuery, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/discover/saved/%v/", organizationSlug, id)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	query := new(SavedQuery)
+	resp, err := s.client.Do(ctx, req, query)
+	if err != nil {
+		return nil, resp, err
+	}
+	return query, resp, nil
+}
+
+// Create a saved query.
+// https://docs.sentry.io/api/discover/create-a-new-saved-query/
+func (s *SavedQueriesService) Create(ctx context.Context, organizationSlug string, params *SavedQuery) (*SavedQuery, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/discover/saved/", organizationSlug)
+	req, err := s.client.NewRequest("POST", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	query := new(SavedQuery)
+	resp, err := s.client.Do(ctx, req, query)
+	if err != nil {
+		return nil, resp, err
+	}
+	return query, resp, nil
+}
+
+// Update a saved query.
+// https://docs.sentry.io/api/discover/edit-an-organizations-discover-saved-query/
+func (s *SavedQueriesService) Update(ctx context.Context, organizationSlug string, id string, params *SavedQuery) (*SavedQuery, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/discover/saved/%v/", organizationSlug, id)
+	req, err := s.client.NewRequest("PUT", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	query := new(SavedQuery)
+	resp, err := s.client.Do(ctx, req, query)
+	if err != nil {
+		return nil, resp, err
+	}
+	return query, resp, nil
+}
+
+// Delete a saved query.
+// https://docs.sentry.io/api/discover/delete-an-organizations-discover-saved-query/
+func (s *SavedQueriesService) Delete(ctx context.Context, organizationSlug string, id string) (*Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/discover/saved/%v/", organizationSlug, id)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// Visit records a visit to a saved query, updating its last visited date.
+func (s *SavedQueriesService) Visit(ctx context.Context, organizationSlug string, id string) (*Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/discover/saved/%v/visit/", organizationSlug, id)
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,188 @@
+package sentry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSavedQueriesService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/discover/saved/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"query": "checkout", "sortBy": "mostPopular"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[
+			{
+				"id": "1",
+				"name": "Checkout latency",
+				"projects": [2],
+				"version": 2,
+				"queryDataset": "transaction-like",
+				"fields": ["transaction", "p95(transaction.duration)"],
+				"orderby": "-p95(transaction.duration)",
+				"environment": ["production"],
+				"query": "transaction:/checkout",
+				"range": "14d",
+				"expired": false,
+				"createdBy": {"id": "1", "name": "Jane", "email": "jane@example.com"},
+				"dateCreated": "2024-01-01T00:00:00Z",
+				"dateUpdated": "2024-01-02T00:00:00Z"
+			}
+		]`)
+	})
+
+	ctx := context.Background()
+	queries, _, err := client.SavedQueries.List(ctx, "the-interstellar-jurisdiction", &ListSavedQueriesParams{
+		Query:  String("checkout"),
+		SortBy: String(SavedQuerySortByMostPopular),
+	})
+	assert.NoError(t, err)
+
+	expected := []*SavedQuery{
+		{
+			ID:           String("1"),
+			Name:         String("Checkout latency"),
+			Projects:     []int{2},
+			Version:      Int(2),
+			QueryDataset: String("transaction-like"),
+			Fields:       []string{"transaction", "p95(transaction.duration)"},
+			OrderBy:      String("-p95(transaction.duration)"),
+			Environment:  []string{"production"},
+			Query:        String("transaction:/checkout"),
+			Range:        String("14d"),
+			Expired:      Bool(false),
+			CreatedBy:    &User{ID: "1", Name: "Jane", Email: "jane@example.com"},
+			DateCreated:  Time(mustParseTime("2024-01-01T00:00:00Z")),
+			DateUpdated:  Time(mustParseTime("2024-01-02T00:00:00Z")),
+		},
+	}
+	assert.Equal(t, expected, queries)
+
+	assert.Equal(t, &DiscoverQueryParams{
+		Field:       []string{"transaction", "p95(transaction.duration)"},
+		Query:       String("transaction:/checkout"),
+		Sort:        []string{"-p95(transaction.duration)"},
+		Project:     []int{2},
+		Environment: []string{"production"},
+		StatsPeriod: String("14d"),
+		Dataset:     String("transaction-like"),
+	}, queries[0].DiscoverQueryParams())
+}
+
+func TestSavedQueriesService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/discover/saved/1/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id": "1", "name": "Errors", "fields": ["count()"], "yAxis": ["count()"], "display": "default", "lastVisited": "2024-01-03T00:00:00Z"}`)
+	})
+
+	ctx := context.Background()
+	query, _, err := client.SavedQueries.Get(ctx, "the-interstellar-jurisdiction", "1")
+	assert.NoError(t, err)
+
+	expected := &SavedQuery{
+		ID:          String("1"),
+		Name:        String("Errors"),
+		Fields:      []string{"count()"},
+		YAxis:       []string{"count()"},
+		Display:     String("default"),
+		LastVisited: Time(mustParseTime("2024-01-03T00:00:00Z")),
+	}
+	assert.Equal(t, expected, query)
+}
+
+func TestSavedQueriesService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/discover/saved/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assertPostJSON(t, map[string]interface{}{
+			"name":         "Slow transactions",
+			"projects":     []interface{}{json.Number("2")},
+			"queryDataset": "transaction-like",
+			"fields":       []interface{}{"transaction", "count()"},
+			"orderby":      "-count()",
+			"environment":  []interface{}{"production"},
+			"range":        "24h",
+			"topEvents":    json.Number("5"),
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id": "2", "name": "Slow transactions"}`)
+	})
+
+	ctx := context.Background()
+	query, _, err := client.SavedQueries.Create(ctx, "the-interstellar-jurisdiction", &SavedQuery{
+		Name:         String("Slow transactions"),
+		Projects:     []int{2},
+		QueryDataset: String("transaction-like"),
+		Fields:       []string{"transaction", "count()"},
+		OrderBy:      String("-count()"),
+		Environment:  []string{"production"},
+		Range:        String("24h"),
+		TopEvents:    Int(5),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &SavedQuery{ID: String("2"), Name: String("Slow transactions")}, query)
+}
+
+func TestSavedQueriesService_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/discover/saved/2/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		assertPostJSON(t, map[string]interface{}{
+			"name":   "Slowest transactions",
+			"fields": []interface{}{"transaction", "count()"},
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id": "2", "name": "Slowest transactions"}`)
+	})
+
+	ctx := context.Background()
+	query, _, err := client.SavedQueries.Update(ctx, "the-interstellar-jurisdiction", "2", &SavedQuery{
+		Name:   String("Slowest transactions"),
+		Fields: []string{"transaction", "count()"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &SavedQuery{ID: String("2"), Name: String("Slowest transactions")}, query)
+}
+
+func TestSavedQueriesService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/discover/saved/2/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.SavedQueries.Delete(ctx, "the-interstellar-jurisdiction", "2")
+	assert.NoError(t, err)
+}
+
+func TestSavedQueriesService_Visit(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/discover/saved/2/visit/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.SavedQueries.Visit(ctx, "the-interstellar-jurisdiction", "2")
+	assert.NoError(t, err)
+}
@@ -3,12 +3,14 @@ package sentry
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"os"
 	"reflect"
 	"strconv"
 	"strings"
@@ -28,6 +30,11 @@ const (
 	headerRateReset               = "X-Sentry-Rate-Limit-Reset"
 	headerRateConcurrentLimit     = "X-Sentry-Rate-Limit-ConcurrentLimit"
 	headerRateConcurrentRemaining = "X-Sentry-Rate-Limit-ConcurrentRemaining"
+
+	// Environment variables shared with sentry-cli.
+	envAuthToken = "SENTRY_AUTH_TOKEN"
+	envAPIKey    = "SENTRY_API_KEY"
+	envURL       = "SENTRY_URL"
 )
 
 var errNonNilContext = errors.New("context must be non-nil")
@@ -42,14 +49,36 @@ type Client struct {
 	// User agent used when communicating with Sentry.
 	UserAgent string
 
+	// Value of the Authorization header sent with every request, if any.
+	authorization string
+
+	// Policy for retrying failed requests. Retries are disabled if nil.
+	RetryPolicy *RetryPolicy
+
+	// Policy for waiting for the async tasks spawned by alert rule changes.
+	// Defaults are used if nil.
+	AsyncTaskPolicy *AsyncTaskPolicy
+
+	// Client-side rate limiter. Disabled if nil.
+	limiter *rateLimiter
+
 	// Common struct used by all services.
 	common service
 
 	// Services
+	ChunkUploads              *ChunkUploadsService
 	Dashboards                *DashboardsService
 	DashboardWidgets          *DashboardWidgetsService
+	DebugFiles                *DebugFilesService
+	Discover                  *DiscoverService
+	Environments              *EnvironmentsService
+	Events                    *EventsService
+	Incidents                 *IncidentsService
 	IssueAlerts               *IssueAlertsService
+	IssueSearches             *IssueSearchesService
+	Issues                    *IssuesService
 	MetricAlerts              *MetricAlertsService
+	Monitors                  *MonitorsService
 	NotificationActions       *NotificationActionsService
 	OrganizationCodeMappings  *OrganizationCodeMappingsService
 	OrganizationIntegrations  *OrganizationIntegrationsService
@@ -65,9 +94,15 @@ type Client struct {
 	Projects                  *ProjectsService
 	ProjectSymbolSources      *ProjectSymbolSourcesService
 	ReleaseDeployments        *ReleaseDeploymentsService
+	ReleaseFiles              *ReleaseFilesService
+	Releases                  *ReleasesService
+	SavedQueries              *SavedQueriesService
 	SpikeProtections          *SpikeProtectionsService
+	Stats                     *StatsService
+	Tags                      *TagsService
 	TeamMembers               *TeamMembersService
 	Teams                     *TeamsService
+	UptimeMonitors            *UptimeMonitorsService
 }
 
 type service struct {
@@ -88,10 +123,19 @@ func NewClient(httpClient *http.Client) *Client {
 		UserAgent: userAgent,
 	}
 	c.common.client = c
+	c.ChunkUploads = (*ChunkUploadsService)(&c.common)
 	c.Dashboards = (*DashboardsService)(&c.common)
 	c.DashboardWidgets = (*DashboardWidgetsService)(&c.common)
+	c.DebugFiles = (*DebugFilesService)(&c.common)
+	c.Discover = (*DiscoverService)(&c.common)
+	c.Environments = (*EnvironmentsService)(&c.common)
+	c.Events = (*EventsService)(&c.common)
+	c.Incidents = (*IncidentsService)(&c.common)
 	c.IssueAlerts = (*IssueAlertsService)(&c.common)
+	c.IssueSearches = (*IssueSearchesService)(&c.common)
+	c.Issues = (*IssuesService)(&c.common)
 	c.MetricAlerts = (*MetricAlertsService)(&c.common)
+	c.Monitors = (*MonitorsService)(&c.common)
 	c.NotificationActions = (*NotificationActionsService)(&c.common)
 	c.OrganizationCodeMappings = (*OrganizationCodeMappingsService)(&c.common)
 	c.OrganizationIntegrations = (*OrganizationIntegrationsService)(&c.common)
@@ -107,9 +151,15 @@ func NewClient(httpClient *http.Client) *Client {
 	c.Projects = (*ProjectsService)(&c.common)
 	c.ProjectSymbolSources = (*ProjectSymbolSourcesService)(&c.common)
 	c.ReleaseDeployments = (*ReleaseDeploymentsService)(&c.common)
+	c.ReleaseFiles = (*ReleaseFilesService)(&c.common)
+	c.Releases = (*ReleasesService)(&c.common)
+	c.SavedQueries = (*SavedQueriesService)(&c.common)
 	c.SpikeProtections = (*SpikeProtectionsService)(&c.common)
+	c.Stats = (*StatsService)(&c.common)
+	c.Tags = (*TagsService)(&c.common)
 	c.TeamMembers = (*TeamMembersService)(&c.common)
 	c.Teams = (*TeamsService)(&c.common)
+	c.UptimeMonitors = (*UptimeMonitorsService)(&c.common)
 	return c
 }
 
@@ -118,6 +168,115 @@ func NewClient(httpClient *http.Client) *Client {
 // If the base URL does not have the suffix "/api/", it will be added automatically.
 // If a nil httpClient is provided, the http.DefaultClient will be used.
 func NewOnPremiseClient(baseURL string, httpClient *http.Client) (*Client, error) {
+	baseEndpoint, err := parseBaseURL(baseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	c := NewClient(httpClient)
+	c.BaseURL = baseEndpoint
+	return c, nil
+}
+
+// ClientOption configures a Client created by NewClientWithOptions.
+type ClientOption func(*Client) error
+
+// NewClientWithOptions returns a new Sentry API client configured by the
+// given options. Options are applied in order, so later options override
+// earlier ones.
+func NewClientWithOptions(opts ...ClientOption) (*Client, error) {
+	c := NewClient(nil)
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// WithHTTPClient sets the HTTP client used to send requests.
+// If a nil httpClient is provided, the http.DefaultClient will be used.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *Client) error {
+		if httpClient == nil {
+			httpClient = http.DefaultClient
+		}
+		c.client = httpClient
+		return nil
+	}
+}
+
+// WithBaseURL sets the base URL of an on-premise Sentry installation.
+// The "/api/" suffix is added automatically, as in NewOnPremiseClient.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		baseEndpoint, err := parseBaseURL(baseURL)
+		if err != nil {
+			return err
+		}
+		c.BaseURL = baseEndpoint
+		return nil
+	}
+}
+
+// WithUserAgent sets the user agent used when communicating with Sentry.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) error {
+		c.UserAgent = userAgent
+		return nil
+	}
+}
+
+// WithAuthToken authenticates requests with a bearer token. Both internal
+// integration tokens and user auth tokens are supported.
+// https://docs.sentry.io/api/auth/
+func WithAuthToken(token string) ClientOption {
+	return func(c *Client) error {
+		if token == "" {
+			return errors.New("auth token must be non-empty")
+		}
+		c.authorization = "Bearer " + token
+		return nil
+	}
+}
+
+// WithAPIKey authenticates requests with a legacy API key using HTTP Basic
+// authentication.
+func WithAPIKey(apiKey string) ClientOption {
+	return func(c *Client) error {
+		if apiKey == "" {
+			return errors.New("API key must be non-empty")
+		}
+		c.authorization = "Basic " + base64.StdEncoding.EncodeToString([]byte(apiKey+":"))
+		return nil
+	}
+}
+
+// WithEnvironmentVariables configures the client from the same environment
+// variables as sentry-cli: SENTRY_AUTH_TOKEN (or the legacy SENTRY_API_KEY)
+// and SENTRY_URL. Unset variables are ignored.
+func WithEnvironmentVariables() ClientOption {
+	return func(c *Client) error {
+		if token := os.Getenv(envAuthToken); token != "" {
+			if err := WithAuthToken(token)(c); err != nil {
+				return err
+			}
+		} else if apiKey := os.Getenv(envAPIKey); apiKey != "" {
+			if err := WithAPIKey(apiKey)(c); err != nil {
+				return err
+			}
+		}
+		if baseURL := os.Getenv(envURL); baseURL != "" {
+			if err := WithBaseURL(baseURL)(c); err != nil {
+				return fmt.Errorf("invalid %s: %w", envURL, err)
+			}
+		}
+		return nil
+	}
+}
+
+// parseBaseURL parses an on-premise base URL and makes sure it ends with "/api/".
+func parseBaseURL(baseURL string) (*url.URL, error) {
 	baseEndpoint, err := url.Parse(baseURL)
 	if err != nil {
 		return nil, err
@@ -129,10 +288,7 @@ func NewOnPremiseClient(baseURL string, httpClient *http.Client) (*Client, error
 	if !strings.HasSuffix(baseEndpoint.Path, "/api/") {
 		baseEndpoint.Path += "api/"
 	}
-
-	c := NewClient(httpClient)
-	c.BaseURL = baseEndpoint
-	return c, nil
+	return baseEndpoint, nil
 }
 
 type ListCursorParams struct {
@@ -162,6 +318,8 @@ func addQuery(s string, params interface{}) (string, error) {
 }
 
 // NewRequest creates an API request.
+// A body of type *MultipartForm is sent as multipart/form-data; any other
+// non-nil body is JSON encoded.
 func (c *Client) NewRequest(method, urlRef string, body interface{}) (*http.Request, error) {
 	if !strings.HasSuffix(c.BaseURL.Path, "/") {
 		return nil, fmt.Errorf("BaseURL must have a trailing slash, but %q does not", c.BaseURL)
@@ -173,7 +331,15 @@ func (c *Client) NewRequest(method, urlRef string, body interface{}) (*http.Requ
 	}
 
 	var buf io.ReadWriter
-	if body != nil {
+	var contentType string
+	if form, ok := body.(*MultipartForm); ok {
+		b := &bytes.Buffer{}
+		contentType, err = form.encode(b)
+		if err != nil {
+			return nil, err
+		}
+		buf = b
+	} else if body != nil {
 		buf = &bytes.Buffer{}
 		enc := json.NewEncoder(buf)
 		enc.SetEscapeHTML(false)
@@ -181,6 +347,7 @@ func (c *Client) NewRequest(method, urlRef string, body interface{}) (*http.Requ
 		if err != nil {
 			return nil, err
 		}
+		contentType = "application/json"
 	}
 
 	req, err := http.NewRequest(method, u.String(), buf)
@@ -188,12 +355,15 @@ func (c *Client) NewRequest(method, urlRef string, body interface{}) (*http.Requ
 		return nil, err
 	}
 
-	if body != nil {
-		req.Header.Set("Content-Type", "application/json")
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
 	}
 	if c.UserAgent != "" {
 		req.Header.Set("User-Agent", c.UserAgent)
 	}
+	if c.authorization != "" {
+		req.Header.Set("Authorization", c.authorization)
+	}
 	return req, nil
 }
 
@@ -207,6 +377,18 @@ type Response struct {
 	// Set ListCursorParams.Cursor to this value when calling the endpoint again.
 	Cursor string
 
+	// Cursors of the next and previous pages, as given in the Link header.
+	// They are only meaningful when HasNext and HasPrev respectively are true.
+	NextCursor string
+	PrevCursor string
+
+	// Whether more results are available after or before the current page.
+	HasNext bool
+	HasPrev bool
+
+	// Links are the parsed Link header relations, keyed by relation name.
+	Links link.Group
+
 	Rate Rate
 }
 
@@ -218,10 +400,36 @@ func newResponse(r *http.Response) *Response {
 }
 
 func (r *Response) populatePaginationCursor() {
-	rels := link.ParseResponse(r.Response)
-	if nextRel, ok := rels["next"]; ok && nextRel.Extra["results"] == "true" {
-		r.Cursor = nextRel.Extra["cursor"]
+	r.Links = link.ParseResponse(r.Response)
+	if nextRel, ok := r.Links["next"]; ok {
+		r.NextCursor = nextRel.Extra["cursor"]
+		r.HasNext = nextRel.Extra["results"] == "true"
+	}
+	if prevRel, ok := r.Links["previous"]; ok {
+		r.PrevCursor = prevRel.Extra["cursor"]
+		r.HasPrev = prevRel.Extra["results"] == "true"
+	}
+	if r.HasNext {
+		r.Cursor = r.NextCursor
+	}
+}
+
+// NextPage returns the parameters to fetch the next page,
+// or nil if there are no more results.
+func (r *Response) NextPage() *ListCursorParams {
+	if !r.HasNext {
+		return nil
 	}
+	return &ListCursorParams{Cursor: r.NextCursor}
+}
+
+// PrevPage returns the parameters to fetch the previous page,
+// or nil if there are no previous results.
+func (r *Response) PrevPage() *ListCursorParams {
+	if !r.HasPrev {
+		return nil
+	}
+	return &ListCursorParams{Cursor: r.PrevCursor}
 }
 
 // ParseRate parses the rate limit headers.
@@ -247,10 +455,27 @@ func ParseRate(r *http.Response) Rate {
 	return rate
 }
 
+// BareDo sends an API request and lets you handle the api response.
+// The request is bound to ctx, so cancellation and deadlines abort the
+// in-flight call and context values are visible to the transport.
 func (c *Client) BareDo(ctx context.Context, req *http.Request) (*Response, error) {
 	if ctx == nil {
 		return nil, errNonNilContext
 	}
+	req = req.WithContext(ctx)
+
+	return c.doWithRetry(ctx, req)
+}
+
+// doOnce sends the request a single time.
+func (c *Client) doOnce(ctx context.Context, req *http.Request) (response *Response, err error) {
+	if c.limiter != nil {
+		key := c.limiter.bucketKey(req)
+		if err := c.limiter.acquire(ctx, key); err != nil {
+			return nil, err
+		}
+		defer func() { c.limiter.release(key, response) }()
+	}
 
 	resp, err := c.client.Do(req)
 	if err != nil {
@@ -264,11 +489,13 @@ func (c *Client) BareDo(ctx context.Context, req *http.Request) (*Response, erro
 		}
 	}
 
-	response := newResponse(resp)
+	response = newResponse(resp)
 	err = CheckResponse(resp)
 	return response, err
 }
 
+// Do sends an API request and decodes the JSON response into v.
+// If v implements io.Writer, the raw response body is copied into it instead.
 func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
 	resp, err := c.BareDo(ctx, req)
 	if err != nil {
@@ -112,6 +112,104 @@ func TestNewOnPremiseClient(t *testing.T) {
 
 }
 
+func TestNewClientWithOptions(t *testing.T) {
+	httpClient := &http.Client{}
+	c, err := NewClientWithOptions(
+		WithHTTPClient(httpClient),
+		WithBaseURL("https://example.com"),
+		WithUserAgent("my-agent"),
+		WithAuthToken("token"),
+	)
+	assert.NoError(t, err)
+	assert.Same(t, httpClient, c.client)
+	assert.Equal(t, "https://example.com/api/", c.BaseURL.String())
+	assert.Equal(t, "my-agent", c.UserAgent)
+
+	req, err := c.NewRequest("GET", "0/organizations/", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "Bearer token", req.Header.Get("Authorization"))
+	assert.Equal(t, "my-agent", req.Header.Get("User-Agent"))
+}
+
+func TestNewClientWithOptions_defaults(t *testing.T) {
+	c, err := NewClientWithOptions()
+	assert.NoError(t, err)
+	assert.Same(t, http.DefaultClient, c.client)
+	assert.Equal(t, "https://sentry.io/api/", c.BaseURL.String())
+
+	req, err := c.NewRequest("GET", "0/organizations/", nil)
+	assert.NoError(t, err)
+	assert.Empty(t, req.Header.Get("Authorization"))
+}
+
+func TestNewClientWithOptions_invalid(t *testing.T) {
+	testCases := []struct {
+		description string
+		opt         ClientOption
+	}{
+		{"empty auth token", WithAuthToken("")},
+		{"empty API key", WithAPIKey("")},
+		{"invalid base URL", WithBaseURL(":")},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			c, err := NewClientWithOptions(tc.opt)
+			assert.Error(t, err)
+			assert.Nil(t, c)
+		})
+	}
+}
+
+func TestWithAPIKey(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	assert.NoError(t, WithAPIKey("api-key")(client))
+
+	mux.HandleFunc("/api/0/organizations/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		username, password, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "api-key", username)
+		assert.Equal(t, "", password)
+		fmt.Fprint(w, `[]`)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.Organizations.List(ctx, nil)
+	assert.NoError(t, err)
+}
+
+func TestWithEnvironmentVariables(t *testing.T) {
+	t.Setenv(envAuthToken, "env-token")
+	t.Setenv(envAPIKey, "env-api-key")
+	t.Setenv(envURL, "https://sentry.example.com/")
+
+	c, err := NewClientWithOptions(WithEnvironmentVariables())
+	assert.NoError(t, err)
+	assert.Equal(t, "https://sentry.example.com/api/", c.BaseURL.String())
+
+	req, err := c.NewRequest("GET", "0/organizations/", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "Bearer env-token", req.Header.Get("Authorization"))
+}
+
+func TestWithEnvironmentVariables_apiKey(t *testing.T) {
+	t.Setenv(envAuthToken, "")
+	t.Setenv(envAPIKey, "env-api-key")
+	t.Setenv(envURL, "")
+
+	c, err := NewClientWithOptions(WithEnvironmentVariables())
+	assert.NoError(t, err)
+	assert.Equal(t, "https://sentry.io/api/", c.BaseURL.String())
+
+	req, err := c.NewRequest("GET", "0/organizations/", nil)
+	assert.NoError(t, err)
+	username, password, ok := req.BasicAuth()
+	assert.True(t, ok)
+	assert.Equal(t, "env-api-key", username)
+	assert.Equal(t, "", password)
+}
+
 func TestResponse_populatePaginationCursor_hasNextResults(t *testing.T) {
 	r := &http.Response{
 		Header: http.Header{
@@ -123,6 +221,13 @@ func TestResponse_populatePaginationCursor_hasNextResults(t *testing.T) {
 
 	response := newResponse(r)
 	assert.Equal(t, response.Cursor, "100:1:0")
+	assert.Equal(t, "100:1:0", response.NextCursor)
+	assert.True(t, response.HasNext)
+	assert.Equal(t, "100:-1:1", response.PrevCursor)
+	assert.False(t, response.HasPrev)
+	assert.Equal(t, &ListCursorParams{Cursor: "100:1:0"}, response.NextPage())
+	assert.Nil(t, response.PrevPage())
+	assert.Equal(t, "https://sentry.io/api/0/organizations/terraform-provider-sentry/members/?&cursor=100:1:0", response.Links["next"].URI)
 }
 
 func TestResponse_populatePaginationCursor_noNextResults(t *testing.T) {
@@ -136,6 +241,25 @@ func TestResponse_populatePaginationCursor_noNextResults(t *testing.T) {
 
 	response := newResponse(r)
 	assert.Equal(t, response.Cursor, "")
+	assert.False(t, response.HasNext)
+	assert.Nil(t, response.NextPage())
+}
+
+func TestResponse_populatePaginationCursor_hasPrevResults(t *testing.T) {
+	r := &http.Response{
+		Header: http.Header{
+			"Link": {`<https://sentry.io/api/0/organizations/terraform-provider-sentry/members/?&cursor=100:1:1>; rel="previous"; results="true"; cursor="100:1:1", ` +
+				`<https://sentry.io/api/0/organizations/terraform-provider-sentry/members/?&cursor=100:3:0>; rel="next"; results="false"; cursor="100:3:0"`,
+			},
+		},
+	}
+
+	response := newResponse(r)
+	assert.Equal(t, "", response.Cursor)
+	assert.False(t, response.HasNext)
+	assert.True(t, response.HasPrev)
+	assert.Equal(t, "100:1:1", response.PrevCursor)
+	assert.Equal(t, &ListCursorParams{Cursor: "100:1:1"}, response.PrevPage())
 }
 
 func TestDo(t *testing.T) {
@@ -194,6 +318,121 @@ func TestDo_nilContext(t *testing.T) {
 	assert.Equal(t, errNonNilContext, err)
 }
 
+func TestDo_contextDeadline(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body)
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	})
+
+	req, _ := client.NewRequest("GET", ".", nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.Do(ctx, req, nil)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestDo_contextCanceled(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		cancel()
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	})
+
+	req, _ := client.NewRequest("GET", ".", nil)
+	_, err := client.Do(ctx, req, nil)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+type contextValueTransport struct {
+	key   interface{}
+	value interface{}
+}
+
+func (t *contextValueTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Context().Value(t.key) != t.value {
+		return nil, fmt.Errorf("context value %v not propagated", t.key)
+	}
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestDo_contextValues(t *testing.T) {
+	type ctxKey struct{}
+
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.client = &http.Client{Transport: &contextValueTransport{key: ctxKey{}, value: "value"}}
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id": "2"}`)
+	})
+
+	ctx := context.WithValue(context.Background(), ctxKey{}, "value")
+	org, _, err := client.Organizations.Get(ctx, "the-interstellar-jurisdiction")
+	assert.NoError(t, err)
+	assert.Equal(t, &Organization{ID: String("2")}, org)
+}
+
+func TestServices_contextDeadline(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body)
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	})
+
+	testCases := []struct {
+		name string
+		call func(ctx context.Context) error
+	}{
+		{"Organizations.List", func(ctx context.Context) error {
+			_, _, err := client.Organizations.List(ctx, nil)
+			return err
+		}},
+		{"Teams.Create", func(ctx context.Context) error {
+			_, _, err := client.Teams.Create(ctx, "org", &CreateTeamParams{Name: String("team")})
+			return err
+		}},
+		{"Projects.Delete", func(ctx context.Context) error {
+			_, err := client.Projects.Delete(ctx, "org", "project")
+			return err
+		}},
+		{"ReleaseDeployments.Get", func(ctx context.Context) error {
+			_, _, err := client.ReleaseDeployments.Get(ctx, "org", "1.0.0", "1")
+			return err
+		}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			err := tc.call(ctx)
+			assert.ErrorIs(t, err, context.DeadlineExceeded)
+		})
+	}
+}
+
 func TestDo_httpErrorPlainText(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
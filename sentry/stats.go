@@ -0,0 +1,151 @@
+package sentry
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Stats fields.
+const (
+	// StatsFieldSumQuantity is the number of events, or bytes for attachments.
+	StatsFieldSumQuantity = "sum(quantity)"
+	// StatsFieldSumTimesSeen is the number of events, counting attachments
+	// as single events.
+	StatsFieldSumTimesSeen = "sum(times_seen)"
+)
+
+// Stats group by keys.
+const (
+	StatsGroupByOutcome  = "outcome"
+	StatsGroupByCategory = "category"
+	StatsGroupByReason   = "reason"
+	StatsGroupByProject  = "project"
+)
+
+// StatsService provides methods for accessing Sentry organization usage stats API endpoints.
+// https://docs.sentry.io/api/organizations/retrieve-event-counts-for-an-organization-v2/
+type StatsService service
+
+// OrganizationStats represents the usage stats of an organization. Each
+// group holds the totals and a time series, with one value per interval,
+// of the requested fields.
+type OrganizationStats struct {
+	Start     *time.Time                `json:"start,omitempty"`
+	End       *time.Time                `json:"end,omitempty"`
+	Intervals []time.Time               `json:"intervals,omitempty"`
+	Groups    []*OrganizationStatsGroup `json:"groups,omitempty"`
+}
+
+// OrganizationStatsGroup represents the stats of a group of outcomes.
+type OrganizationStatsGroup struct {
+	By     OrganizationStatsGroupBy `json:"by"`
+	Totals map[string]float64       `json:"totals,omitempty"`
+	Series map[string][]float64     `json:"series,omitempty"`
+}
+
+// OrganizationStatsGroupBy holds the values identifying a group. Only the
+// keys requested with GroupBy are set.
+type OrganizationStatsGroupBy struct {
+	Outcome  *string `json:"outcome,omitempty"`
+	Category *string `json:"category,omitempty"`
+	Reason   *string `json:"reason,omitempty"`
+	Project  *int64  `json:"project,omitempty"`
+}
+
+// GetOrganizationStatsParams are the parameters for StatsService.Get.
+type GetOrganizationStatsParams struct {
+	// Field is StatsFieldSumQuantity or StatsFieldSumTimesSeen.
+	Field   []string `url:"field,omitempty"`
+	GroupBy []string `url:"groupBy,omitempty"`
+	// Interval is the resolution of the time series, such as "1h" or "1d".
+	Interval    *string    `url:"interval,omitempty"`
+	StatsPeriod *string    `url:"statsPeriod,omitempty"`
+	Start       *time.Time `url:"start,omitempty"`
+	End         *time.Time `url:"end,omitempty"`
+	Project     []int64    `url:"project,omitempty"`
+	Category    []string   `url:"category,omitempty"`
+	Outcome     []string   `url:"outcome,omitempty"`
+	Reason      []string   `url:"reason,omitempty"`
+}
+
+// Get the usage stats of an organization.
+// https://docs.sentry.io/api/organizations/retrieve-event-counts-for-an-organization-v2/
+func (s *StatsService) Get(ctx context.Context, organizationSlug string, params *GetOrganizationStatsParams) (*OrganizationStats, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/stats_v2/", organizationSlug)
+	u, err := addQuery(u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stats := new(OrganizationStats)
+	resp, err := s.client.Do(ctx, req, stats)
+	if err != nil {
+		return nil, resp, err
+	}
+	return stats, resp, nil
+}
+
+// TotalsByProject returns the totals of field summed per project ID.
+// The stats must be grouped by StatsGroupByProject.
+func (s *OrganizationStats) TotalsByProject(field string) map[int64]float64 {
+	totals := map[int64]float64{}
+	for _, g := range s.Groups {
+		if g.By.Project != nil {
+			totals[*g.By.Project] += g.Totals[field]
+		}
+	}
+	return totals
+}
+
+// TotalsByCategory returns the totals of field summed per data category.
+// The stats must be grouped by StatsGroupByCategory.
+func (s *OrganizationStats) TotalsByCategory(field string) map[string]float64 {
+	totals := map[string]float64{}
+	for _, g := range s.Groups {
+		if g.By.Category != nil {
+			totals[*g.By.Category] += g.Totals[field]
+		}
+	}
+	return totals
+}
+
+// SeriesByProject returns the time series of field summed per project ID.
+// The stats must be grouped by StatsGroupByProject.
+func (s *OrganizationStats) SeriesByProject(field string) map[int64][]float64 {
+	series := map[int64][]float64{}
+	for _, g := range s.Groups {
+		if g.By.Project != nil {
+			series[*g.By.Project] = addSeries(series[*g.By.Project], g.Series[field])
+		}
+	}
+	return series
+}
+
+// SeriesByCategory returns the time series of field summed per data category.
+// The stats must be grouped by StatsGroupByCategory.
+func (s *OrganizationStats) SeriesByCategory(field string) map[string][]float64 {
+	series := map[string][]float64{}
+	for _, g := range s.Groups {
+		if g.By.Category != nil {
+			series[*g.By.Category] = addSeries(series[*g.By.Category], g.Series[field])
+		}
+	}
+	return series
+}
+
+// addSeries adds b to a element-wise, growing a if needed.
+func addSeries(a, b []float64) []float64 {
+	for len(a) < len(b) {
+		a = append(a, 0)
+	}
+	for i, v := range b {
+		a[i] += v
+	}
+	return a
+}
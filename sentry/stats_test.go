@@ -0,0 +1,96 @@
+package sentry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatsService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/stats_v2/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assert.Equal(t, url.Values{
+			"field":       {"sum(quantity)"},
+			"groupBy":     {"project", "category"},
+			"interval":    {"1d"},
+			"statsPeriod": {"2d"},
+			"outcome":     {"accepted"},
+		}, r.URL.Query())
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"start": "2024-01-01T00:00:00Z",
+			"end": "2024-01-03T00:00:00Z",
+			"intervals": ["2024-01-01T00:00:00Z", "2024-01-02T00:00:00Z"],
+			"groups": [
+				{
+					"by": {"project": 1, "category": "error"},
+					"totals": {"sum(quantity)": 30},
+					"series": {"sum(quantity)": [10, 20]}
+				},
+				{
+					"by": {"project": 1, "category": "transaction"},
+					"totals": {"sum(quantity)": 5},
+					"series": {"sum(quantity)": [5, 0]}
+				},
+				{
+					"by": {"project": 2, "category": "error"},
+					"totals": {"sum(quantity)": 7},
+					"series": {"sum(quantity)": [3, 4]}
+				}
+			]
+		}`)
+	})
+
+	ctx := context.Background()
+	stats, _, err := client.Stats.Get(ctx, "the-interstellar-jurisdiction", &GetOrganizationStatsParams{
+		Field:       []string{StatsFieldSumQuantity},
+		GroupBy:     []string{StatsGroupByProject, StatsGroupByCategory},
+		Interval:    String("1d"),
+		StatsPeriod: String("2d"),
+		Outcome:     []string{"accepted"},
+	})
+	assert.NoError(t, err)
+
+	project1 := int64(1)
+	project2 := int64(2)
+	expected := &OrganizationStats{
+		Start: Time(mustParseTime("2024-01-01T00:00:00Z")),
+		End:   Time(mustParseTime("2024-01-03T00:00:00Z")),
+		Intervals: []time.Time{
+			mustParseTime("2024-01-01T00:00:00Z"),
+			mustParseTime("2024-01-02T00:00:00Z"),
+		},
+		Groups: []*OrganizationStatsGroup{
+			{
+				By:     OrganizationStatsGroupBy{Project: &project1, Category: String("error")},
+				Totals: map[string]float64{"sum(quantity)": 30},
+				Series: map[string][]float64{"sum(quantity)": {10, 20}},
+			},
+			{
+				By:     OrganizationStatsGroupBy{Project: &project1, Category: String("transaction")},
+				Totals: map[string]float64{"sum(quantity)": 5},
+				Series: map[string][]float64{"sum(quantity)": {5, 0}},
+			},
+			{
+				By:     OrganizationStatsGroupBy{Project: &project2, Category: String("error")},
+				Totals: map[string]float64{"sum(quantity)": 7},
+				Series: map[string][]float64{"sum(quantity)": {3, 4}},
+			},
+		},
+	}
+	assert.Equal(t, expected, stats)
+
+	assert.Equal(t, map[int64]float64{1: 35, 2: 7}, stats.TotalsByProject(StatsFieldSumQuantity))
+	assert.Equal(t, map[string]float64{"error": 37, "transaction": 5}, stats.TotalsByCategory(StatsFieldSumQuantity))
+	assert.Equal(t, map[int64][]float64{1: {15, 20}, 2: {3, 4}}, stats.SeriesByProject(StatsFieldSumQuantity))
+	assert.Equal(t, map[string][]float64{"error": {13, 24}, "transaction": {5, 0}}, stats.SeriesByCategory(StatsFieldSumQuantity))
+	assert.Equal(t, map[int64]float64{1: 0, 2: 0}, stats.TotalsByProject(StatsFieldSumTimesSeen))
+}
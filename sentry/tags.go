@@ -0,0 +1,172 @@
+package sentry
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// TagKey represents a tag key of a project or issue. TopValues is only set
+// for issue tag distributions.
+// https://github.com/getsentry/sentry/blob/24.10.0/src/sentry/api/serializers/models/tagvalue.py
+type TagKey struct {
+	Key          *string     `json:"key,omitempty"`
+	Name         *string     `json:"name,omitempty"`
+	UniqueValues *int        `json:"uniqueValues,omitempty"`
+	TotalValues  *int        `json:"totalValues,omitempty"`
+	CanDelete    *bool       `json:"canDelete,omitempty"`
+	TopValues    []*TagValue `json:"topValues,omitempty"`
+}
+
+// TagValue represents a value of a tag key.
+type TagValue struct {
+	Key       *string    `json:"key,omitempty"`
+	Name      *string    `json:"name,omitempty"`
+	Value     *string    `json:"value,omitempty"`
+	Count     *int       `json:"count,omitempty"`
+	FirstSeen *time.Time `json:"firstSeen,omitempty"`
+	LastSeen  *time.Time `json:"lastSeen,omitempty"`
+}
+
+// TagsService provides methods for accessing Sentry tag API endpoints.
+type TagsService service
+
+// ListProjectTagKeysParams are the parameters for TagsService.ListKeys.
+type ListProjectTagKeysParams struct {
+	Environment []string `url:"environment,omitempty"`
+}
+
+// ListKeys lists the tag keys of a project.
+func (s *TagsService) ListKeys(ctx context.Context, organizationSlug string, projectSlug string, params *ListProjectTagKeysParams) ([]*TagKey, *Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/tags/", organizationSlug, projectSlug)
+	return s.listKeys(ctx, u, params)
+}
+
+func (s *TagsService) listKeys(ctx context.Context, u string, params interface{}) ([]*TagKey, *Response, error) {
+	u, err := addQuery(u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keys := []*TagKey{}
+	resp, err := s.client.Do(ctx, req, &keys)
+	if err != nil {
+		return nil, resp, err
+	}
+	return keys, resp, nil
+}
+
+// ListTagValuesParams are the parameters for TagsService.ListValues and
+// TagsService.ListIssueValues.
+type ListTagValuesParams struct {
+	ListCursorParams
+
+	// Query filters values by substring.
+	Query       *string  `url:"query,omitempty"`
+	Environment []string `url:"environment,omitempty"`
+	Sort        *string  `url:"sort,omitempty"`
+}
+
+// ListValues lists the values of a tag key of a project.
+// https://docs.sentry.io/api/projects/list-a-tags-values/
+func (s *TagsService) ListValues(ctx context.Context, organizationSlug string, projectSlug string, key string, params *ListTagValuesParams) ([]*TagValue, *Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/tags/%v/values/", organizationSlug, projectSlug, url.PathEscape(key))
+	return s.listValues(ctx, u, params)
+}
+
+// ListAllValues returns all values of a tag key of a project, following pagination.
+func (s *TagsService) ListAllValues(ctx context.Context, organizationSlug string, projectSlug string, key string, params *ListTagValuesParams) ([]*TagValue, *Response, error) {
+	p := ListTagValuesParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewPager(func(ctx context.Context, cursor string) ([]*TagValue, *Response, error) {
+		p.Cursor = cursor
+		return s.ListValues(ctx, organizationSlug, projectSlug, key, &p)
+	}).All(ctx)
+}
+
+func (s *TagsService) listValues(ctx context.Context, u string, params *ListTagValuesParams) ([]*TagValue, *Response, error) {
+	u, err := addQuery(u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	values := []*TagValue{}
+	resp, err := s.client.Do(ctx, req, &values)
+	if err != nil {
+		return nil, resp, err
+	}
+	return values, resp, nil
+}
+
+// DeleteKey deletes a tag key and its values from a project.
+func (s *TagsService) DeleteKey(ctx context.Context, organizationSlug string, projectSlug string, key string) (*Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/tags/%v/", organizationSlug, projectSlug, url.PathEscape(key))
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListIssueTagsParams are the parameters for TagsService.ListIssueTags.
+type ListIssueTagsParams struct {
+	Environment []string `url:"environment,omitempty"`
+	// Key limits the result to the given tag keys.
+	Key []string `url:"key,omitempty"`
+	// Limit is the number of top values returned per tag key.
+	Limit *int `url:"limit,omitempty"`
+}
+
+// ListIssueTags lists the tag distributions of an issue, with the top values of each tag key.
+func (s *TagsService) ListIssueTags(ctx context.Context, organizationSlug string, issueID string, params *ListIssueTagsParams) ([]*TagKey, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/issues/%v/tags/", organizationSlug, issueID)
+	return s.listKeys(ctx, u, params)
+}
+
+// GetIssueTagParams are the parameters for TagsService.GetIssueTag.
+type GetIssueTagParams struct {
+	Environment []string `url:"environment,omitempty"`
+}
+
+// GetIssueTag returns the distribution of a tag key of an issue.
+// https://docs.sentry.io/api/events/retrieve-tag-details/
+func (s *TagsService) GetIssueTag(ctx context.Context, organizationSlug string, issueID string, key string, params *GetIssueTagParams) (*TagKey, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/issues/%v/tags/%v/", organizationSlug, issueID, url.PathEscape(key))
+	u, err := addQuery(u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tag := new(TagKey)
+	resp, err := s.client.Do(ctx, req, tag)
+	if err != nil {
+		return nil, resp, err
+	}
+	return tag, resp, nil
+}
+
+// ListIssueValues lists the values of a tag key of an issue.
+// https://docs.sentry.io/api/events/list-a-tags-values-related-to-an-issue/
+func (s *TagsService) ListIssueValues(ctx context.Context, organizationSlug string, issueID string, key string, params *ListTagValuesParams) ([]*TagValue, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/issues/%v/tags/%v/values/", organizationSlug, issueID, url.PathEscape(key))
+	return s.listValues(ctx, u, params)
+}
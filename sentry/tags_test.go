@@ -0,0 +1,207 @@
+package sentry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTagsService_ListKeys(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/tags/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"environment": "production"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[
+			{"key": "release", "name": "Release", "uniqueValues": 12, "canDelete": true},
+			{"key": "customer_id", "name": "Customer Id", "uniqueValues": 340, "canDelete": true}
+		]`)
+	})
+
+	ctx := context.Background()
+	keys, _, err := client.Tags.ListKeys(ctx, "the-interstellar-jurisdiction", "pump-station", &ListProjectTagKeysParams{
+		Environment: []string{"production"},
+	})
+	assert.NoError(t, err)
+
+	expected := []*TagKey{
+		{Key: String("release"), Name: String("Release"), UniqueValues: Int(12), CanDelete: Bool(true)},
+		{Key: String("customer_id"), Name: String("Customer Id"), UniqueValues: Int(340), CanDelete: Bool(true)},
+	}
+	assert.Equal(t, expected, keys)
+}
+
+func TestTagsService_ListValues(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/tags/customer_id/values/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"query": "acme", "cursor": "100:1:0"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[
+			{
+				"key": "customer_id",
+				"name": "acme-42",
+				"value": "acme-42",
+				"count": 7,
+				"firstSeen": "2024-01-01T00:00:00Z",
+				"lastSeen": "2024-01-02T00:00:00Z"
+			}
+		]`)
+	})
+
+	ctx := context.Background()
+	values, _, err := client.Tags.ListValues(ctx, "the-interstellar-jurisdiction", "pump-station", "customer_id", &ListTagValuesParams{
+		ListCursorParams: ListCursorParams{Cursor: "100:1:0"},
+		Query:            String("acme"),
+	})
+	assert.NoError(t, err)
+
+	expected := []*TagValue{
+		{
+			Key:       String("customer_id"),
+			Name:      String("acme-42"),
+			Value:     String("acme-42"),
+			Count:     Int(7),
+			FirstSeen: Time(mustParseTime("2024-01-01T00:00:00Z")),
+			LastSeen:  Time(mustParseTime("2024-01-02T00:00:00Z")),
+		},
+	}
+	assert.Equal(t, expected, values)
+}
+
+func TestTagsService_ListAllValues(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/tags/release/values/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assert.Equal(t, "1.", r.URL.Query().Get("query"))
+		w.Header().Set("Content-Type", "application/json")
+		u := serverURL + "/api/0/projects/the-interstellar-jurisdiction/pump-station/tags/release/values/"
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			w.Header().Set("Link", fmt.Sprintf(`<%s?&cursor=100:1:0>; rel="next"; results="true"; cursor="100:1:0"`, u))
+			fmt.Fprint(w, `[{"value": "1.1"}]`)
+		case "100:1:0":
+			w.Header().Set("Link", fmt.Sprintf(`<%s?&cursor=100:2:0>; rel="next"; results="false"; cursor="100:2:0"`, u))
+			fmt.Fprint(w, `[{"value": "1.0"}]`)
+		}
+	})
+
+	ctx := context.Background()
+	values, _, err := client.Tags.ListAllValues(ctx, "the-interstellar-jurisdiction", "pump-station", "release", &ListTagValuesParams{
+		Query: String("1."),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []*TagValue{{Value: String("1.1")}, {Value: String("1.0")}}, values)
+}
+
+func TestTagsService_DeleteKey(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/tags/customer_id/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Tags.DeleteKey(ctx, "the-interstellar-jurisdiction", "pump-station", "customer_id")
+	assert.NoError(t, err)
+}
+
+func TestTagsService_ListIssueTags(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/issues/1/tags/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assert.Equal(t, url.Values{
+			"key":   {"browser", "release"},
+			"limit": {"3"},
+		}, r.URL.Query())
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[
+			{
+				"key": "browser",
+				"name": "Browser",
+				"totalValues": 30,
+				"topValues": [
+					{"key": "browser", "name": "Chrome 120", "value": "Chrome 120", "count": 20},
+					{"key": "browser", "name": "Firefox 121", "value": "Firefox 121", "count": 10}
+				]
+			}
+		]`)
+	})
+
+	ctx := context.Background()
+	tags, _, err := client.Tags.ListIssueTags(ctx, "the-interstellar-jurisdiction", "1", &ListIssueTagsParams{
+		Key:   []string{"browser", "release"},
+		Limit: Int(3),
+	})
+	assert.NoError(t, err)
+
+	expected := []*TagKey{
+		{
+			Key:         String("browser"),
+			Name:        String("Browser"),
+			TotalValues: Int(30),
+			TopValues: []*TagValue{
+				{Key: String("browser"), Name: String("Chrome 120"), Value: String("Chrome 120"), Count: Int(20)},
+				{Key: String("browser"), Name: String("Firefox 121"), Value: String("Firefox 121"), Count: Int(10)},
+			},
+		},
+	}
+	assert.Equal(t, expected, tags)
+}
+
+func TestTagsService_GetIssueTag(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/issues/1/tags/sentry:user/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"environment": "production"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"key": "user", "name": "User", "uniqueValues": 2, "totalValues": 5, "topValues": []}`)
+	})
+
+	ctx := context.Background()
+	tag, _, err := client.Tags.GetIssueTag(ctx, "the-interstellar-jurisdiction", "1", "sentry:user", &GetIssueTagParams{
+		Environment: []string{"production"},
+	})
+	assert.NoError(t, err)
+
+	expected := &TagKey{
+		Key:          String("user"),
+		Name:         String("User"),
+		UniqueValues: Int(2),
+		TotalValues:  Int(5),
+		TopValues:    []*TagValue{},
+	}
+	assert.Equal(t, expected, tag)
+}
+
+func TestTagsService_ListIssueValues(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/issues/1/tags/release/values/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"key": "release", "value": "1.0", "count": 3}]`)
+	})
+
+	ctx := context.Background()
+	values, _, err := client.Tags.ListIssueValues(ctx, "the-interstellar-jurisdiction", "1", "release", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []*TagValue{{Key: String("release"), Value: String("1.0"), Count: Int(3)}}, values)
+}
@@ -50,6 +50,13 @@ func (s *TeamsService) List(ctx context.Context, organizationSlug string, params
 	return teams, resp, nil
 }
 
+// ListAll returns all teams bound to an organization, following pagination.
+func (s *TeamsService) ListAll(ctx context.Context, organizationSlug string) ([]*Team, *Response, error) {
+	return NewPager(func(ctx context.Context, cursor string) ([]*Team, *Response, error) {
+		return s.List(ctx, organizationSlug, &ListCursorParams{Cursor: cursor})
+	}).All(ctx)
+}
+
 // Get details on an individual team of an organization.
 // https://docs.sentry.io/api/teams/retrieve-a-team/
 func (s *TeamsService) Get(ctx context.Context, organizationSlug string, slug string) (*Team, *Response, error) {
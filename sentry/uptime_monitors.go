@@ -0,0 +1,159 @@
+package sentry
+
+import (
+	"context"
+	"fmt"
+)
+
+// Uptime monitor statuses.
+const (
+	UptimeMonitorStatusActive   = "active"
+	UptimeMonitorStatusDisabled = "disabled"
+)
+
+// UptimeMonitor represents a Sentry uptime alert rule, an HTTP check of a URL.
+// https://github.com/getsentry/sentry/blob/24.10.0/src/sentry/uptime/endpoints/serializers.py
+type UptimeMonitor struct {
+	ID              *string       `json:"id,omitempty"`
+	ProjectSlug     *string       `json:"projectSlug,omitempty"`
+	Name            *string       `json:"name,omitempty"`
+	Status          *string       `json:"status,omitempty"`
+	Mode            *int          `json:"mode,omitempty"`
+	Environment     *string       `json:"environment,omitempty"`
+	URL             *string       `json:"url,omitempty"`
+	Method          *string       `json:"method,omitempty"`
+	Headers         [][]string    `json:"headers,omitempty"`
+	Body            *string       `json:"body,omitempty"`
+	IntervalSeconds *int          `json:"intervalSeconds,omitempty"`
+	TimeoutMs       *int          `json:"timeoutMs,omitempty"`
+	Owner           *MonitorOwner `json:"owner,omitempty"`
+	TraceSampling   *bool         `json:"traceSampling,omitempty"`
+}
+
+// UptimeMonitorsService provides methods for accessing Sentry uptime alert rule API endpoints.
+// https://github.com/getsentry/sentry/blob/24.10.0/src/sentry/uptime/endpoints/project_uptime_alert_index.py
+// https://github.com/getsentry/sentry/blob/24.10.0/src/sentry/uptime/endpoints/project_uptime_alert_details.py
+type UptimeMonitorsService service
+
+// ListUptimeMonitorsParams are the parameters for UptimeMonitorsService.List.
+type ListUptimeMonitorsParams struct {
+	ListCursorParams
+
+	Query       *string  `url:"query,omitempty"`
+	Project     []int    `url:"project,omitempty"`
+	Environment []string `url:"environment,omitempty"`
+	// Owner filters by owning actors, such as "user:1" or "team:2".
+	Owner []string `url:"owner,omitempty"`
+}
+
+// List the uptime alert rules of an organization.
+func (s *UptimeMonitorsService) List(ctx context.Context, organizationSlug string, params *ListUptimeMonitorsParams) ([]*UptimeMonitor, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/uptime/", organizationSlug)
+	u, err := addQuery(u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	monitors := []*UptimeMonitor{}
+	resp, err := s.client.Do(ctx, req, &monitors)
+	if err != nil {
+		return nil, resp, err
+	}
+	return monitors, resp, nil
+}
+
+// ListAll returns all uptime alert rules of an organization, following pagination.
+func (s *UptimeMonitorsService) ListAll(ctx context.Context, organizationSlug string, params *ListUptimeMonitorsParams) ([]*UptimeMonitor, *Response, error) {
+	p := ListUptimeMonitorsParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewPager(func(ctx context.Context, cursor string) ([]*UptimeMonitor, *Response, error) {
+		p.Cursor = cursor
+		return s.List(ctx, organizationSlug, &p)
+	}).All(ctx)
+}
+
+// Get an uptime alert rule of a project.
+func (s *UptimeMonitorsService) Get(ctx context.Context, organizationSlug string, projectSlug string, id string) (*UptimeMonitor, *Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/uptime/%v/", organizationSlug, projectSlug, id)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	monitor := new(UptimeMonitor)
+	resp, err := s.client.Do(ctx, req, monitor)
+	if err != nil {
+		return nil, resp, err
+	}
+	return monitor, resp, nil
+}
+
+// UptimeMonitorParams are the parameters for UptimeMonitorsService.Create
+// and UptimeMonitorsService.Update.
+type UptimeMonitorParams struct {
+	Name        *string `json:"name,omitempty"`
+	Status      *string `json:"status,omitempty"`
+	Environment *string `json:"environment,omitempty"`
+	URL         *string `json:"url,omitempty"`
+	Method      *string `json:"method,omitempty"`
+	// Headers are sent as name and value pairs.
+	Headers [][]string `json:"headers,omitempty"`
+	Body    *string    `json:"body,omitempty"`
+	// IntervalSeconds is the time between checks, one of 60, 300, 600,
+	// 1200, 1800 or 3600.
+	IntervalSeconds *int `json:"interval_seconds,omitempty"`
+	TimeoutMs       *int `json:"timeout_ms,omitempty"`
+	// Owner is the actor owning the rule, such as "user:1" or "team:2".
+	Owner         *string `json:"owner,omitempty"`
+	TraceSampling *bool   `json:"trace_sampling,omitempty"`
+}
+
+// Create an uptime alert rule bound to a project.
+func (s *UptimeMonitorsService) Create(ctx context.Context, organizationSlug string, projectSlug string, params *UptimeMonitorParams) (*UptimeMonitor, *Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/uptime/", organizationSlug, projectSlug)
+	req, err := s.client.NewRequest("POST", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	monitor := new(UptimeMonitor)
+	resp, err := s.client.Do(ctx, req, monitor)
+	if err != nil {
+		return nil, resp, err
+	}
+	return monitor, resp, nil
+}
+
+// Update an uptime alert rule.
+func (s *UptimeMonitorsService) Update(ctx context.Context, organizationSlug string, projectSlug string, id string, params *UptimeMonitorParams) (*UptimeMonitor, *Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/uptime/%v/", organizationSlug, projectSlug, id)
+	req, err := s.client.NewRequest("PUT", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	monitor := new(UptimeMonitor)
+	resp, err := s.client.Do(ctx, req, monitor)
+	if err != nil {
+		return nil, resp, err
+	}
+	return monitor, resp, nil
+}
+
+// Delete an uptime alert rule.
+func (s *UptimeMonitorsService) Delete(ctx context.Context, organizationSlug string, projectSlug string, id string) (*Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/uptime/%v/", organizationSlug, projectSlug, id)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,175 @@
+package sentry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUptimeMonitorsService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/uptime/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"project": "2", "owner": "team:2"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"id": "1", "projectSlug": "pump-station", "name": "Homepage"}]`)
+	})
+
+	ctx := context.Background()
+	monitors, _, err := client.UptimeMonitors.List(ctx, "the-interstellar-jurisdiction", &ListUptimeMonitorsParams{
+		Project: []int{2},
+		Owner:   []string{"team:2"},
+	})
+	assert.NoError(t, err)
+
+	expected := []*UptimeMonitor{
+		{ID: String("1"), ProjectSlug: String("pump-station"), Name: String("Homepage")},
+	}
+	assert.Equal(t, expected, monitors)
+}
+
+func TestUptimeMonitorsService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/uptime/1/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"id": "1",
+			"projectSlug": "pump-station",
+			"environment": "production",
+			"name": "Homepage",
+			"status": "active",
+			"mode": 1,
+			"url": "https://example.com/health",
+			"method": "POST",
+			"body": "{\"ping\": true}",
+			"headers": [["Content-Type", "application/json"]],
+			"intervalSeconds": 300,
+			"timeoutMs": 5000,
+			"owner": {"type": "user", "id": "1", "name": "Jane", "email": "jane@example.com"},
+			"traceSampling": false
+		}`)
+	})
+
+	ctx := context.Background()
+	monitor, _, err := client.UptimeMonitors.Get(ctx, "the-interstellar-jurisdiction", "pump-station", "1")
+	assert.NoError(t, err)
+
+	expected := &UptimeMonitor{
+		ID:              String("1"),
+		ProjectSlug:     String("pump-station"),
+		Environment:     String("production"),
+		Name:            String("Homepage"),
+		Status:          String(UptimeMonitorStatusActive),
+		Mode:            Int(1),
+		URL:             String("https://example.com/health"),
+		Method:          String("POST"),
+		Body:            String(`{"ping": true}`),
+		Headers:         [][]string{{"Content-Type", "application/json"}},
+		IntervalSeconds: Int(300),
+		TimeoutMs:       Int(5000),
+		Owner: &MonitorOwner{
+			Type:  String("user"),
+			ID:    String("1"),
+			Name:  String("Jane"),
+			Email: String("jane@example.com"),
+		},
+		TraceSampling: Bool(false),
+	}
+	assert.Equal(t, expected, monitor)
+}
+
+func TestUptimeMonitorsService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/uptime/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assertPostJSON(t, map[string]interface{}{
+			"name":             "Homepage",
+			"environment":      "production",
+			"url":              "https://example.com/health",
+			"method":           "GET",
+			"headers":          []interface{}{[]interface{}{"Accept", "text/html"}},
+			"interval_seconds": json.Number("60"),
+			"timeout_ms":       json.Number("1000"),
+			"owner":            "team:2",
+			"trace_sampling":   true,
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": "1", "name": "Homepage", "intervalSeconds": 60}`)
+	})
+
+	ctx := context.Background()
+	monitor, _, err := client.UptimeMonitors.Create(ctx, "the-interstellar-jurisdiction", "pump-station", &UptimeMonitorParams{
+		Name:            String("Homepage"),
+		Environment:     String("production"),
+		URL:             String("https://example.com/health"),
+		Method:          String("GET"),
+		Headers:         [][]string{{"Accept", "text/html"}},
+		IntervalSeconds: Int(60),
+		TimeoutMs:       Int(1000),
+		Owner:           String("team:2"),
+		TraceSampling:   Bool(true),
+	})
+	assert.NoError(t, err)
+
+	expected := &UptimeMonitor{
+		ID:              String("1"),
+		Name:            String("Homepage"),
+		IntervalSeconds: Int(60),
+	}
+	assert.Equal(t, expected, monitor)
+}
+
+func TestUptimeMonitorsService_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/uptime/1/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		assertPostJSON(t, map[string]interface{}{
+			"status":     "disabled",
+			"timeout_ms": json.Number("2000"),
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id": "1", "status": "disabled", "timeoutMs": 2000}`)
+	})
+
+	ctx := context.Background()
+	monitor, _, err := client.UptimeMonitors.Update(ctx, "the-interstellar-jurisdiction", "pump-station", "1", &UptimeMonitorParams{
+		Status:    String(UptimeMonitorStatusDisabled),
+		TimeoutMs: Int(2000),
+	})
+	assert.NoError(t, err)
+
+	expected := &UptimeMonitor{
+		ID:        String("1"),
+		Status:    String("disabled"),
+		TimeoutMs: Int(2000),
+	}
+	assert.Equal(t, expected, monitor)
+}
+
+func TestUptimeMonitorsService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/uptime/1/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.UptimeMonitors.Delete(ctx, "the-interstellar-jurisdiction", "pump-station", "1")
+	assert.NoError(t, err)
+}